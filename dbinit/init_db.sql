@@ -1,16 +1,49 @@
+CREATE TABLE departments (
+	id serial PRIMARY KEY,
+	code text NOT NULL UNIQUE,
+	name text NOT NULL
+);
+
+CREATE TABLE programs (
+	id serial PRIMARY KEY,
+	department_id int NOT NULL,
+	code text NOT NULL UNIQUE,
+	name text NOT NULL,
+	degree text NOT NULL,
+
+	FOREIGN KEY (department_id) REFERENCES departments(id)
+);
+
 CREATE TABLE students (
 	id serial PRIMARY KEY,
 	student_id varchar(6) NOT NULL,
 	first_name text NOT NULL,
 	last_name text NOT NULL,
-	date_of_birth timestamp NOT NULL
+	date_of_birth timestamp NOT NULL,
+	program_id int,
+
+	FOREIGN KEY (program_id) REFERENCES programs(id)
 );
 
 CREATE TABLE teachers (
 	id serial PRIMARY KEY,
 	first_name text NOT NULL,
 	last_name text NOT NULL,
-	date_of_birth timestamp NOT NULL
+	date_of_birth timestamp NOT NULL,
+	archived_at timestamp,
+	department_id int,
+
+	FOREIGN KEY (department_id) REFERENCES departments(id)
+);
+
+CREATE TABLE terms (
+	id serial PRIMARY KEY,
+	name text NOT NULL UNIQUE,
+	start_date date NOT NULL,
+	end_date date NOT NULL,
+	status text NOT NULL DEFAULT 'planned',
+
+	CHECK (start_date < end_date)
 );
 
 CREATE TABLE courses (
@@ -18,17 +51,169 @@ CREATE TABLE courses (
 	name text NOT NULL,
 	start_time timestamp NOT NULL,
 	end_time timestamp NOT NULL,
+	capacity int CHECK (capacity > 0),
+	credits int NOT NULL DEFAULT 0 CHECK (credits >= 0),
+	registration_opens_at timestamp,
+	registration_closes_at timestamp,
+	term_id int NOT NULL,
 	teacher_id int NOT NULL,
+	department_id int,
 
-	FOREIGN KEY (teacher_id) REFERENCES teachers(id)
+	CHECK (registration_opens_at < registration_closes_at),
+	FOREIGN KEY (term_id) REFERENCES terms(id),
+	FOREIGN KEY (teacher_id) REFERENCES teachers(id),
+	FOREIGN KEY (department_id) REFERENCES departments(id)
+);
+
+CREATE TABLE course_meeting_patterns (
+	course_id int PRIMARY KEY,
+	days_of_week text[] NOT NULL,
+	start_time text NOT NULL,
+	duration_minutes int NOT NULL CHECK (duration_minutes > 0),
+	time_zone text NOT NULL,
+	excluded_dates date[] NOT NULL DEFAULT '{}',
+
+	FOREIGN KEY (course_id) REFERENCES courses(id)
+);
+
+CREATE TABLE course_sessions (
+	id serial PRIMARY KEY,
+	course_id int NOT NULL,
+	session_date date NOT NULL,
+	start_time timestamp NOT NULL,
+	end_time timestamp NOT NULL,
+
+	UNIQUE (course_id, start_time),
+	FOREIGN KEY (course_id) REFERENCES courses(id)
 );
 
 CREATE TABLE students_courses (
 	id serial PRIMARY KEY,
 	student_id int NOT NULL,
 	course_id int NOT NULL,
+	status text NOT NULL DEFAULT 'active',
+	withdrawal_reason text,
+	withdrawn_at timestamp,
+	withdrawn_by text,
+	grade_letter text,
+	grade_score numeric(5, 2) CHECK (grade_score BETWEEN 0 AND 100),
+	graded_at timestamp,
 
 	FOREIGN KEY (student_id) REFERENCES students(id),
 	FOREIGN KEY (course_id) REFERENCES courses(id)
 
-);
\ No newline at end of file
+);
+
+CREATE TABLE enrollment_status_history (
+	id serial PRIMARY KEY,
+	enrollment_id int NOT NULL,
+	from_status text,
+	to_status text NOT NULL,
+	reason text,
+	changed_by text,
+	changed_at timestamp NOT NULL DEFAULT now(),
+
+	FOREIGN KEY (enrollment_id) REFERENCES students_courses(id)
+);
+
+CREATE TABLE attendance (
+	id serial PRIMARY KEY,
+	enrollment_id int NOT NULL,
+	session_date date NOT NULL,
+	status text NOT NULL,
+	note text,
+	recorded_by text NOT NULL,
+	recorded_at timestamp NOT NULL DEFAULT now(),
+
+	UNIQUE (enrollment_id, session_date),
+	FOREIGN KEY (enrollment_id) REFERENCES students_courses(id)
+);
+
+CREATE TABLE course_registration_overrides (
+	course_id int NOT NULL,
+	student_id int NOT NULL,
+	granted_by text NOT NULL,
+	granted_at timestamp NOT NULL DEFAULT now(),
+
+	PRIMARY KEY (course_id, student_id),
+	FOREIGN KEY (course_id) REFERENCES courses(id),
+	FOREIGN KEY (student_id) REFERENCES students(id)
+);
+
+CREATE TABLE student_credit_overrides (
+	student_id int PRIMARY KEY,
+	max_credits int NOT NULL CHECK (max_credits > 0),
+	approved_by text NOT NULL,
+	approved_at timestamp NOT NULL DEFAULT now(),
+
+	FOREIGN KEY (student_id) REFERENCES students(id)
+);
+
+CREATE TABLE course_prerequisites (
+	course_id int NOT NULL,
+	prerequisite_id int NOT NULL,
+
+	PRIMARY KEY (course_id, prerequisite_id),
+	CHECK (course_id <> prerequisite_id),
+	FOREIGN KEY (course_id) REFERENCES courses(id),
+	FOREIGN KEY (prerequisite_id) REFERENCES courses(id)
+);
+
+-- The primary teacher of a course stays in courses.teacher_id, these are the other teachers of the course
+CREATE TABLE course_teachers (
+	course_id int NOT NULL,
+	teacher_id int NOT NULL,
+	role text NOT NULL CHECK (role IN ('lead', 'assistant', 'grader')),
+
+	PRIMARY KEY (course_id, teacher_id),
+	FOREIGN KEY (course_id) REFERENCES courses(id),
+	FOREIGN KEY (teacher_id) REFERENCES teachers(id)
+);
+
+CREATE TABLE rooms (
+	id serial PRIMARY KEY,
+	building text NOT NULL,
+	number text NOT NULL,
+	capacity int NOT NULL CHECK (capacity > 0),
+	features text[] NOT NULL DEFAULT '{}',
+
+	UNIQUE (building, number)
+);
+
+-- A course books a room for all of its sessions, a booking with a session date moves that one session to another room
+CREATE TABLE room_bookings (
+	id serial PRIMARY KEY,
+	room_id int NOT NULL,
+	course_id int NOT NULL,
+	session_date date,
+	booked_at timestamp NOT NULL DEFAULT now(),
+
+	FOREIGN KEY (room_id) REFERENCES rooms(id),
+	FOREIGN KEY (course_id) REFERENCES courses(id)
+);
+
+CREATE UNIQUE INDEX room_bookings_course ON room_bookings (course_id) WHERE session_date IS NULL;
+CREATE UNIQUE INDEX room_bookings_session ON room_bookings (course_id, session_date) WHERE session_date IS NOT NULL;
+
+-- A courses requirement needs every listed course, a credits requirement needs credits from any of them.
+-- With a minimum grade a course only counts when its grade reaches it.
+CREATE TABLE program_requirements (
+	id serial PRIMARY KEY,
+	program_id int NOT NULL,
+	name text NOT NULL,
+	kind text NOT NULL CHECK (kind IN ('courses', 'credits')),
+	credits int CHECK (credits > 0),
+	min_grade text,
+
+	CHECK ((kind = 'credits') = (credits IS NOT NULL)),
+	FOREIGN KEY (program_id) REFERENCES programs(id)
+);
+
+CREATE TABLE program_requirement_courses (
+	requirement_id int NOT NULL,
+	course_id int NOT NULL,
+
+	PRIMARY KEY (requirement_id, course_id),
+	FOREIGN KEY (requirement_id) REFERENCES program_requirements(id),
+	FOREIGN KEY (course_id) REFERENCES courses(id)
+);
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"student_rest/models"
+	"time"
+
+	"github.com/go-chi/chi"
+	"student_rest/services"
+)
+
+type AttendanceHandlers struct {
+	services.AttendanceServices
+}
+
+// MarkRoster records the attendance of the course roster at the session held on the date in the path
+func (_self AttendanceHandlers) MarkRoster(w http.ResponseWriter, r *http.Request) {
+	courseID := chi.URLParam(r, "id")
+	sessionDate := chi.URLParam(r, "date")
+
+	if _, err := time.Parse("2006-01-02", sessionDate); err != nil {
+		http.Error(w, "session date must be a YYYY-MM-DD date", http.StatusBadRequest)
+		return
+	}
+
+	var roster MarkRosterRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&roster); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := roster.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedRoster := transformMarkRosterRequestToMarkRosterModel(sessionDate, roster)
+	result, err := _self.AttendanceServices.MarkRoster(courseID, &convertedRoster)
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(AttendanceResponse{
+		Success:    true,
+		Attendance: result,
+	})
+}
+
+func transformMarkRosterRequestToMarkRosterModel(sessionDate string, request MarkRosterRequest) models.MarkRosterModel {
+	records := make([]*models.AttendanceRecordModel, 0, len(request.Records))
+	for _, record := range request.Records {
+		records = append(records, &models.AttendanceRecordModel{
+			StudentID: record.StudentID,
+			Status:    record.Status,
+			Note:      record.Note,
+		})
+	}
+	return models.MarkRosterModel{
+		SessionDate: sessionDate,
+		RecordedBy:  request.RecordedBy,
+		Records:     records,
+	}
+}
+
+func (_self AttendanceHandlers) GetCourseAttendance(w http.ResponseWriter, r *http.Request) {
+	courseID := chi.URLParam(r, "id")
+	result, err := _self.AttendanceServices.GetCourseAttendance(courseID)
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(CourseAttendanceResponse{
+		Success:    true,
+		Attendance: result,
+	})
+}
+
+func (_self AttendanceHandlers) GetStudentAttendance(w http.ResponseWriter, r *http.Request) {
+	studentID := chi.URLParam(r, "id")
+	result, err := _self.AttendanceServices.GetStudentAttendance(studentID)
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(StudentAttendanceResponse{
+		Success:    true,
+		Attendance: result,
+	})
+}
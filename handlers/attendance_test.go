@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockAttendanceService struct {
+	mock.Mock
+}
+
+func (m *MockAttendanceService) MarkRoster(courseID string, roster *models.MarkRosterModel) ([]*repositories.AttendanceEntity, error) {
+	returnArgs := m.Called(courseID, roster)
+	return returnArgs.Get(0).([]*repositories.AttendanceEntity), returnArgs.Error(1)
+}
+
+func (m *MockAttendanceService) GetCourseAttendance(courseID string) (*repositories.CourseAttendanceEntity, error) {
+	returnArgs := m.Called(courseID)
+	return returnArgs.Get(0).(*repositories.CourseAttendanceEntity), returnArgs.Error(1)
+}
+
+func (m *MockAttendanceService) GetStudentAttendance(studentID string) (*repositories.StudentAttendanceSummaryEntity, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).(*repositories.StudentAttendanceSummaryEntity), returnArgs.Error(1)
+}
+
+func Test_MarkRoster(t *testing.T) {
+	note := "doctor's note"
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramDate            string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.MarkRosterModel
+		mockServiceResult    []*repositories.AttendanceEntity
+		mockServiceError     error
+	}{
+		{
+			name:                 "invalid session date",
+			paramID:              "1",
+			paramDate:            "11-02-2020",
+			requestBody:          map[string]interface{}{},
+			expectedResponseBody: "session date must be a YYYY-MM-DD date\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:      "invalid attendance status",
+			paramID:   "1",
+			paramDate: "2020-11-02",
+			requestBody: map[string]interface{}{
+				"recordedBy": "teacher@school.edu",
+				"records": []map[string]interface{}{
+					{"studentID": 1, "status": "sick"},
+				},
+			},
+			expectedResponseBody: "invalid attendance status: sick\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:      "student listed twice",
+			paramID:   "1",
+			paramDate: "2020-11-02",
+			requestBody: map[string]interface{}{
+				"recordedBy": "teacher@school.edu",
+				"records": []map[string]interface{}{
+					{"studentID": 1, "status": "present"},
+					{"studentID": 1, "status": "late"},
+				},
+			},
+			expectedResponseBody: "student 1 is listed more than once\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:      "students not enrolled",
+			paramID:   "1",
+			paramDate: "2020-11-02",
+			requestBody: map[string]interface{}{
+				"recordedBy": "teacher@school.edu",
+				"records": []map[string]interface{}{
+					{"studentID": 1, "status": "present"},
+					{"studentID": 3, "status": "absent"},
+				},
+			},
+			expectedResponseBody: "{\"success\":false,\"error\":\"students are not enrolled in this course: 3\",\"details\":[3]}\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput: &models.MarkRosterModel{
+				SessionDate: "2020-11-02",
+				RecordedBy:  "teacher@school.edu",
+				Records: []*models.AttendanceRecordModel{
+					{StudentID: 1, Status: models.AttendanceStatusPresent},
+					{StudentID: 3, Status: models.AttendanceStatusAbsent},
+				},
+			},
+			mockServiceResult: nil,
+			mockServiceError:  &models.StudentsNotEnrolledError{StudentIDs: []int{3}},
+		},
+		{
+			name:      "mark roster successfully",
+			paramID:   "1",
+			paramDate: "2020-11-02",
+			requestBody: map[string]interface{}{
+				"recordedBy": "teacher@school.edu",
+				"records": []map[string]interface{}{
+					{"studentID": 1, "status": "excused", "note": "doctor's note"},
+				},
+			},
+			expectedResponseBody: "{\"success\":true,\"attendance\":[{\"id\":1,\"enrollmentID\":4,\"studentID\":1,\"sessionDate\":\"2020-11-02T00:00:00Z\",\"status\":\"excused\",\"note\":\"doctor's note\",\"recordedBy\":\"teacher@school.edu\",\"recordedAt\":\"2020-11-02T10:00:00Z\"}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.MarkRosterModel{
+				SessionDate: "2020-11-02",
+				RecordedBy:  "teacher@school.edu",
+				Records: []*models.AttendanceRecordModel{
+					{StudentID: 1, Status: models.AttendanceStatusExcused, Note: "doctor's note"},
+				},
+			},
+			mockServiceResult: []*repositories.AttendanceEntity{
+				{
+					ID:           1,
+					EnrollmentID: 4,
+					StudentID:    1,
+					SessionDate:  "2020-11-02T00:00:00Z",
+					Status:       models.AttendanceStatusExcused,
+					Note:         &note,
+					RecordedBy:   "teacher@school.edu",
+					RecordedAt:   "2020-11-02T10:00:00Z",
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockAttendanceService)
+			mockService.On("MarkRoster", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			attendanceHandler := AttendanceHandlers{
+				AttendanceServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/courses/course/{id}/sessions/{date}/attendance", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("date", testCase.paramDate)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(attendanceHandler.MarkRoster)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetStudentAttendance(t *testing.T) {
+	rate := 75.0
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceResult    *repositories.StudentAttendanceSummaryEntity
+		mockServiceError     error
+	}{
+		{
+			name:                 "student not found",
+			paramID:              "9",
+			expectedResponseBody: "student not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:                 "get student attendance successfully",
+			paramID:              "1",
+			expectedResponseBody: "{\"success\":true,\"attendance\":{\"student\":{\"id\":1,\"studentID\":\"123456\",\"firstName\":\"Anh\",\"lastName\":\"Le\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\"},\"total\":{\"sessions\":4,\"present\":2,\"absent\":1,\"late\":1,\"excused\":0,\"rate\":75},\"courses\":[{\"courseID\":1,\"courseName\":\"Math\",\"attendance\":{\"sessions\":4,\"present\":2,\"absent\":1,\"late\":1,\"excused\":0,\"rate\":75}}]}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult: &repositories.StudentAttendanceSummaryEntity{
+				Student: &repositories.StudentEntity{
+					ID:          1,
+					StudentID:   "123456",
+					FirstName:   "Anh",
+					LastName:    "Le",
+					DateOfBirth: "1998-11-02T00:00:00Z",
+				},
+				Total: &repositories.AttendanceSummaryEntity{Sessions: 4, Present: 2, Absent: 1, Late: 1, Rate: &rate},
+				Courses: []*repositories.CourseAttendanceSummaryEntity{
+					{
+						CourseID:   1,
+						CourseName: "Math",
+						Attendance: &repositories.AttendanceSummaryEntity{Sessions: 4, Present: 2, Absent: 1, Late: 1, Rate: &rate},
+					},
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockAttendanceService)
+			mockService.On("GetStudentAttendance", testCase.paramID).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			attendanceHandler := AttendanceHandlers{
+				AttendanceServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/students/student/{id}/attendance", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(attendanceHandler.GetStudentAttendance)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"student_rest/models"
+	"time"
+	"unicode/utf8"
+)
+
+const mediaTypeCalendar = "text/calendar"
+
+// calendarTimeFormat is the UTC form of an iCalendar DATE-TIME
+const calendarTimeFormat = "20060102T150405Z"
+
+// calendarTextEscaper escapes the characters that are special in iCalendar TEXT values
+var calendarTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// writeCalendar writes the events as an RFC 5545 calendar.
+// Times are written in UTC so the calendar doesn't need the VTIMEZONE definitions a TZID would require,
+// clients show them in the time zone of the user.
+func writeCalendar(w io.Writer, name string, events []*models.ScheduleEventModel, stamp time.Time) error {
+	writer := bufio.NewWriter(w)
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//student_rest//schedule//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + calendarTextEscaper.Replace(name),
+	}
+	for _, event := range events {
+		startTime, err := calendarTime(event.StartTime)
+		if err != nil {
+			return err
+		}
+		endTime, err := calendarTime(event.EndTime)
+		if err != nil {
+			return err
+		}
+
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+event.UID,
+			"DTSTAMP:"+stamp.UTC().Format(calendarTimeFormat),
+			"DTSTART:"+startTime,
+			"DTEND:"+endTime,
+			"SUMMARY:"+calendarTextEscaper.Replace(event.CourseName),
+		)
+		if event.Teacher != "" {
+			lines = append(lines, "DESCRIPTION:"+calendarTextEscaper.Replace("Teacher: "+event.Teacher))
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := writer.WriteString(foldCalendarLine(line)); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// calendarTime converts an RFC 3339 time to the UTC form of an iCalendar DATE-TIME
+func calendarTime(value string) (string, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", err
+	}
+	return t.UTC().Format(calendarTimeFormat), nil
+}
+
+// foldCalendarLine ends the line with CRLF and folds it so no line is longer than 75 octets,
+// a continuation line starts with a space. Multi-byte characters are never split.
+func foldCalendarLine(line string) string {
+	var folded strings.Builder
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		folded.WriteString(line[:cut])
+		folded.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space of a continuation line counts towards its length
+		limit = 74
+	}
+	folded.WriteString(line)
+	folded.WriteString("\r\n")
+	return folded.String()
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"student_rest/models"
+	"student_rest/repositories"
+	"time"
 
 	"github.com/go-chi/chi"
 	"student_rest/services"
@@ -31,7 +33,7 @@ func (_self CourseHandlers) CreateCourse(w http.ResponseWriter, r *http.Request)
 	result, err := _self.CourseServices.CreateCourse(&convertedCourse)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -47,9 +49,20 @@ func TransformCourseRequestToCourseModel(request CourseRequest) models.CourseMod
 		Name:      request.Name,
 		StartTime: request.StartTime,
 		EndTime:   request.EndTime,
-		Teacher:   &models.TeacherModel{
+		Capacity:  request.Capacity,
+		Credits:   request.Credits,
+
+		RegistrationOpensAt:  request.RegistrationOpensAt,
+		RegistrationClosesAt: request.RegistrationClosesAt,
+
+		Term: &models.TermModel{
+			ID: request.TermID,
+		},
+		Teacher: &models.TeacherModel{
 			ID: request.TeacherID,
 		},
+
+		DepartmentID: request.DepartmentID,
 	}
 }
 
@@ -68,6 +81,25 @@ func (_self CourseHandlers) GetCourseByID(w http.ResponseWriter, r *http.Request
 	})
 }
 
+// GetCourses lists the courses, the termId and teacherId query parameters limit them to one term or one teacher
+func (_self CourseHandlers) GetCourses(w http.ResponseWriter, r *http.Request) {
+	result, err := _self.CourseServices.GetCourses(&models.CourseFilterModel{
+		TermID:       r.URL.Query().Get("termId"),
+		TeacherID:    r.URL.Query().Get("teacherId"),
+		DepartmentID: r.URL.Query().Get("departmentId"),
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(CoursesResponse{
+		Success: true,
+		Courses: result,
+	})
+}
+
 func (_self CourseHandlers) DeleteCourse(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 
@@ -100,7 +132,503 @@ func (_self CourseHandlers) UpdateCourse(w http.ResponseWriter, r *http.Request)
 	err := _self.CourseServices.UpdateCourse(id, &convertedCourse)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self CourseHandlers) GetCourseStudents(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	query := r.URL.Query()
+	filterRequest := RosterFilterRequest{
+		Limit:  query.Get("limit"),
+		Offset: query.Get("offset"),
+		SortBy: query.Get("sortBy"),
+		Order:  query.Get("order"),
+	}
+
+	filter, err := filterRequest.transform()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, total, err := _self.CourseServices.GetCourseStudents(id, filter)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(CourseStudentsResponse{
+		Success:  true,
+		Students: result,
+		Total:    total,
+		Limit:    filter.Limit,
+		Offset:   filter.Offset,
+	})
+}
+
+func (_self CourseHandlers) GetPrerequisites(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.CourseServices.GetPrerequisites(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(PrerequisitesResponse{
+		Success:       true,
+		Prerequisites: result,
+	})
+}
+
+func (_self CourseHandlers) AddPrerequisite(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	prerequisiteID := chi.URLParam(r, "prerequisiteId")
+
+	if err := _self.CourseServices.AddPrerequisite(id, prerequisiteID); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self CourseHandlers) DeletePrerequisite(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	prerequisiteID := chi.URLParam(r, "prerequisiteId")
+
+	if err := _self.CourseServices.DeletePrerequisite(id, prerequisiteID); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self CourseHandlers) BulkEnrollCourse(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var bulkEnrollment BulkEnrollmentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&bulkEnrollment); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := bulkEnrollment.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mode := bulkEnrollment.Mode
+	if mode == "" {
+		mode = models.BulkEnrollmentAllOrNothing
+	}
+
+	results, err := _self.CourseServices.BulkEnrollCourse(id, &models.BulkEnrollmentModel{
+		StudentIDs:   bulkEnrollment.StudentIDs,
+		StudentCodes: bulkEnrollment.StudentCodes,
+		Mode:         mode,
+		Options: &models.EnrollmentOptionsModel{
+			OverrideScheduleConflict: bulkEnrollment.OverrideScheduleConflict,
+			OverriddenBy:             bulkEnrollment.OverriddenBy,
+		},
+	})
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	success := true
+	for _, result := range results {
+		success = success && result.Success
+	}
+	// Nothing has been written when an all-or-nothing batch fails
+	if !success && mode == models.BulkEnrollmentAllOrNothing {
+		w.WriteHeader(http.StatusConflict)
+	}
+
+	json.NewEncoder(w).Encode(BulkEnrollmentResponse{
+		Success: success,
+		Mode:    mode,
+		Results: results,
+	})
+}
+
+// SetRegistrationOverride lets an admin allow a late add for the student
+func (_self CourseHandlers) SetRegistrationOverride(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	studentID := chi.URLParam(r, "studentId")
+
+	var override RegistrationOverrideRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&override); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := override.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := _self.CourseServices.SetRegistrationOverride(id, studentID, override.GrantedBy); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self CourseHandlers) DeleteRegistrationOverride(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	studentID := chi.URLParam(r, "studentId")
+
+	if err := _self.CourseServices.DeleteRegistrationOverride(id, studentID); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self CourseHandlers) RecordGrade(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	studentID := chi.URLParam(r, "studentId")
+
+	var grade GradeRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&grade); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := grade.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.CourseServices.RecordGrade(id, studentID, &models.RecordGradeModel{
+		Letter: grade.Letter,
+		Score:  grade.Score,
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(EnrollmentResponse{
+		Success:    true,
+		Enrollment: result,
+	})
+}
+
+func (_self CourseHandlers) SetMeetingPattern(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var pattern MeetingPatternRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&pattern); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := pattern.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.CourseServices.SetMeetingPattern(id, &models.MeetingPatternModel{
+		DaysOfWeek:      pattern.DaysOfWeek,
+		StartTime:       pattern.StartTime,
+		DurationMinutes: pattern.DurationMinutes,
+		TimeZone:        pattern.TimeZone,
+		ExcludedDates:   pattern.ExcludedDates,
+	})
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(MeetingPatternResponse{
+		Success:        true,
+		MeetingPattern: result,
+	})
+}
+
+func (_self CourseHandlers) GetMeetingPattern(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.CourseServices.GetMeetingPattern(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(MeetingPatternResponse{
+		Success:        true,
+		MeetingPattern: result,
+	})
+}
+
+func (_self CourseHandlers) DeleteMeetingPattern(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	if err := _self.CourseServices.DeleteMeetingPattern(id); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self CourseHandlers) GetCourseSessions(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.CourseServices.GetCourseSessions(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SessionsResponse{
+		Success:  true,
+		Sessions: result,
+	})
+}
+
+func (_self CourseHandlers) GetCourseTeachers(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.CourseServices.GetCourseTeachers(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(CourseTeachersResponse{
+		Success:  true,
+		Teachers: transformCourseTeacherModelsToResponses(result),
+	})
+}
+
+// SetCourseTeacher adds the teacher to the course with the role from the body, or changes their role
+func (_self CourseHandlers) SetCourseTeacher(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	teacherID := chi.URLParam(r, "teacherId")
+
+	var teacher CourseTeacherRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&teacher); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := teacher.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := _self.CourseServices.SetCourseTeacher(id, teacherID, teacher.Role); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self CourseHandlers) DeleteCourseTeacher(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	teacherID := chi.URLParam(r, "teacherId")
+
+	if err := _self.CourseServices.DeleteCourseTeacher(id, teacherID); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+// GetCoursesV2 lists the courses with all of their teachers, it takes the same query parameters as GetCourses
+func (_self CourseHandlers) GetCoursesV2(w http.ResponseWriter, r *http.Request) {
+	result, err := _self.CourseServices.GetCoursesWithTeachers(&models.CourseFilterModel{
+		TermID:       r.URL.Query().Get("termId"),
+		TeacherID:    r.URL.Query().Get("teacherId"),
+		DepartmentID: r.URL.Query().Get("departmentId"),
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	courses := make([]*CourseV2, 0, len(result))
+	for _, course := range result {
+		courses = append(courses, transformCourseModelToCourseV2(course))
+	}
+
+	json.NewEncoder(w).Encode(CoursesV2Response{
+		Success: true,
+		Courses: courses,
+	})
+}
+
+func (_self CourseHandlers) GetCourseByIDV2(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.CourseServices.GetCourseWithTeachers(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(CourseV2Response{
+		Success: true,
+		Course:  transformCourseModelToCourseV2(result),
+	})
+}
+
+func transformCourseModelToCourseV2(model *models.CourseModel) *CourseV2 {
+	course := &CourseV2{
+		ID:                   model.ID,
+		Name:                 model.Name,
+		StartTime:            model.StartTime,
+		EndTime:              model.EndTime,
+		Capacity:             model.Capacity,
+		Credits:              model.Credits,
+		Enrolled:             model.Enrolled,
+		RegistrationOpensAt:  model.RegistrationOpensAt,
+		RegistrationClosesAt: model.RegistrationClosesAt,
+		Teachers:             transformCourseTeacherModelsToResponses(model.Teachers),
+		DepartmentID:         model.DepartmentID,
+	}
+	if model.Term != nil {
+		course.Term = &repositories.TermEntity{
+			ID:        model.Term.ID,
+			Name:      model.Term.Name,
+			StartDate: model.Term.StartDate,
+			EndDate:   model.Term.EndDate,
+			Status:    model.Term.Status,
+		}
+	}
+	return course
+}
+
+func transformCourseTeacherModelsToResponses(teachers []*models.CourseTeacherModel) []*CourseTeacherResponse {
+	responses := make([]*CourseTeacherResponse, 0, len(teachers))
+	for _, model := range teachers {
+		responses = append(responses, &CourseTeacherResponse{
+			ID:        model.Teacher.ID,
+			FirstName: model.Teacher.FirstName,
+			LastName:  model.Teacher.LastName,
+			Role:      model.Role,
+		})
+	}
+	return responses
+}
+
+func (_self CourseHandlers) GetCourseRooms(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.CourseServices.GetCourseRooms(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(CourseRoomsResponse{
+		Success: true,
+		Rooms:   result,
+	})
+}
+
+// BookCourseRoom books the room from the body for all the sessions of the course
+func (_self CourseHandlers) BookCourseRoom(w http.ResponseWriter, r *http.Request) {
+	_self.bookRoom(w, r, "")
+}
+
+// BookSessionRoom books the room from the body for the session of the course on the date, instead of the course room
+func (_self CourseHandlers) BookSessionRoom(w http.ResponseWriter, r *http.Request) {
+	sessionDate := chi.URLParam(r, "date")
+
+	if _, err := time.Parse("2006-01-02", sessionDate); err != nil {
+		http.Error(w, "session date must be a YYYY-MM-DD date", http.StatusBadRequest)
+		return
+	}
+
+	_self.bookRoom(w, r, sessionDate)
+}
+
+func (_self CourseHandlers) bookRoom(w http.ResponseWriter, r *http.Request, sessionDate string) {
+	id := chi.URLParam(r, "id")
+
+	var booking RoomBookingRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&booking); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := booking.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := _self.CourseServices.BookRoom(id, sessionDate, booking.RoomID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self CourseHandlers) DeleteCourseRoom(w http.ResponseWriter, r *http.Request) {
+	_self.deleteRoomBooking(w, r, "")
+}
+
+func (_self CourseHandlers) DeleteSessionRoom(w http.ResponseWriter, r *http.Request) {
+	sessionDate := chi.URLParam(r, "date")
+
+	if _, err := time.Parse("2006-01-02", sessionDate); err != nil {
+		http.Error(w, "session date must be a YYYY-MM-DD date", http.StatusBadRequest)
+		return
+	}
+
+	_self.deleteRoomBooking(w, r, sessionDate)
+}
+
+func (_self CourseHandlers) deleteRoomBooking(w http.ResponseWriter, r *http.Request, sessionDate string) {
+	id := chi.URLParam(r, "id")
+
+	if err := _self.CourseServices.DeleteRoomBooking(id, sessionDate); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"student_rest/models"
+	"student_rest/repositories"
 	"testing"
 )
 
@@ -28,6 +29,11 @@ func (m *MockCourseService) GetCourseByID(id string) (*models.CourseModel, error
 	return returnArgs.Get(0).(*models.CourseModel), returnArgs.Error(1)
 }
 
+func (m *MockCourseService) GetCourses(filter *models.CourseFilterModel) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
 func (m *MockCourseService) DeleteCourse(id string) error {
 	returnArgs := m.Called(id)
 	return returnArgs.Error(0)
@@ -38,6 +44,106 @@ func (m *MockCourseService) UpdateCourse(id string, course *models.CourseModel)
 	return returnArgs.Error(0)
 }
 
+func (m *MockCourseService) GetCourseStudents(id string, filter *models.RosterFilterModel) ([]*repositories.StudentEntity, int, error) {
+	returnArgs := m.Called(id, filter)
+	return returnArgs.Get(0).([]*repositories.StudentEntity), returnArgs.Int(1), returnArgs.Error(2)
+}
+
+func (m *MockCourseService) GetPrerequisites(id string) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) AddPrerequisite(id string, prerequisiteID string) error {
+	returnArgs := m.Called(id, prerequisiteID)
+	return returnArgs.Error(0)
+}
+
+func (m *MockCourseService) DeletePrerequisite(id string, prerequisiteID string) error {
+	returnArgs := m.Called(id, prerequisiteID)
+	return returnArgs.Error(0)
+}
+
+func (m *MockCourseService) BulkEnrollCourse(id string, bulk *models.BulkEnrollmentModel) ([]*repositories.BulkEnrollmentResultEntity, error) {
+	returnArgs := m.Called(id, bulk)
+	return returnArgs.Get(0).([]*repositories.BulkEnrollmentResultEntity), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) SetRegistrationOverride(id string, studentID string, grantedBy string) error {
+	returnArgs := m.Called(id, studentID, grantedBy)
+	return returnArgs.Error(0)
+}
+
+func (m *MockCourseService) DeleteRegistrationOverride(id string, studentID string) error {
+	returnArgs := m.Called(id, studentID)
+	return returnArgs.Error(0)
+}
+
+func (m *MockCourseService) RecordGrade(id string, studentID string, grade *models.RecordGradeModel) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(id, studentID, grade)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) SetMeetingPattern(id string, pattern *models.MeetingPatternModel) (*repositories.MeetingPatternEntity, error) {
+	returnArgs := m.Called(id, pattern)
+	return returnArgs.Get(0).(*repositories.MeetingPatternEntity), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) GetMeetingPattern(id string) (*repositories.MeetingPatternEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.MeetingPatternEntity), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) DeleteMeetingPattern(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+func (m *MockCourseService) GetCourseSessions(id string) ([]*repositories.SessionEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*repositories.SessionEntity), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) GetCourseWithTeachers(id string) (*models.CourseModel, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*models.CourseModel), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) GetCoursesWithTeachers(filter *models.CourseFilterModel) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) GetCourseTeachers(id string) ([]*models.CourseTeacherModel, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*models.CourseTeacherModel), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) SetCourseTeacher(id string, teacherID string, role string) error {
+	returnArgs := m.Called(id, teacherID, role)
+	return returnArgs.Error(0)
+}
+
+func (m *MockCourseService) DeleteCourseTeacher(id string, teacherID string) error {
+	returnArgs := m.Called(id, teacherID)
+	return returnArgs.Error(0)
+}
+
+func (m *MockCourseService) GetCourseRooms(id string) ([]*repositories.CourseRoomEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*repositories.CourseRoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockCourseService) BookRoom(id string, date string, roomID int) error {
+	returnArgs := m.Called(id, date, roomID)
+	return returnArgs.Error(0)
+}
+
+func (m *MockCourseService) DeleteRoomBooking(id string, date string) error {
+	returnArgs := m.Called(id, date)
+	return returnArgs.Error(0)
+}
+
 func Test_CreateCourse(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -64,12 +170,43 @@ func Test_CreateCourse(t *testing.T) {
 			expectedResponseBody: "course name is required\n",
 			expectedStatus:       http.StatusBadRequest,
 		},
+		{
+			name: "validate registration window fail",
+			requestBody: map[string]interface{}{
+				"name":                 "Math",
+				"registrationOpensAt":  "2020-11-01T00:00:00Z",
+				"registrationClosesAt": "2020-10-01T00:00:00Z",
+			},
+			expectedResponseBody: "registration must open before it closes\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name: "validate capacity fail",
+			requestBody: map[string]interface{}{
+				"name":     "Math",
+				"capacity": 0,
+			},
+			expectedResponseBody: "capacity must be greater than 0\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name: "validate course end fail",
+			requestBody: map[string]interface{}{
+				"name":      "Math",
+				"startTime": "2020-11-03T00:00:00Z",
+				"endTime":   "2020-11-03T00:00:00Z",
+				"termID":    1,
+			},
+			expectedResponseBody: "course must start before it ends\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
 		{
 			name: "create course fail",
 			requestBody: map[string]interface{}{
 				"name":      "Math",
 				"startTime": "2020-11-02T00:00:00Z",
 				"endTime":   "2020-11-03T00:00:00Z",
+				"termID":    1,
 				"teacherID": 1,
 			},
 			expectedResponseBody: "create course fail\n",
@@ -78,6 +215,9 @@ func Test_CreateCourse(t *testing.T) {
 				Name:      "Math",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -91,14 +231,18 @@ func Test_CreateCourse(t *testing.T) {
 				"name":      "Physics",
 				"startTime": "2020-11-02T00:00:00Z",
 				"endTime":   "2020-11-03T00:00:00Z",
+				"termID":    1,
 				"teacherID": 1,
 			},
-			expectedResponseBody: "{\"success\":true,\"course\":{\"ID\":1,\"Name\":\"Physics\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Teacher\":{\"ID\":1,\"FirstName\":\"Mai\",\"LastName\":\"Dao\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}}\n",
+			expectedResponseBody: "{\"success\":true,\"course\":{\"ID\":1,\"Name\":\"Physics\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":{\"ID\":1,\"FirstName\":\"Mai\",\"LastName\":\"Dao\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}}\n",
 			expectedStatus:       http.StatusOK,
 			mockServiceInput: &models.CourseModel{
 				Name:      "Physics",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -171,7 +315,7 @@ func Test_GetCourseByID(t *testing.T) {
 		{
 			name:                 "get course by id successfully",
 			paramID:              "2",
-			expectedResponseBody: "{\"success\":true,\"course\":{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Teacher\":{\"ID\":1,\"FirstName\":\"Mai\",\"LastName\":\"Dao\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}}\n",
+			expectedResponseBody: "{\"success\":true,\"course\":{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":{\"ID\":1,\"FirstName\":\"Mai\",\"LastName\":\"Dao\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}}\n",
 			expectedStatus:       http.StatusOK,
 			mockServiceInput:     "2",
 			mockServiceResult: &models.CourseModel{
@@ -220,6 +364,81 @@ func Test_GetCourseByID(t *testing.T) {
 	}
 }
 
+func Test_GetCourses(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		query                string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.CourseFilterModel
+		mockServiceResult    []*models.CourseModel
+		mockServiceError     error
+	}{
+		{
+			name:                 "term not found",
+			query:                "?termId=9",
+			expectedResponseBody: "term not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     &models.CourseFilterModel{TermID: "9"},
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrTermNotFound,
+		},
+		{
+			name:                 "get courses of a term successfully",
+			query:                "?termId=1",
+			expectedResponseBody: "{\"success\":true,\"courses\":[{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":{\"ID\":1,\"Name\":\"Fall 2020\",\"StartDate\":\"2020-08-15T00:00:00Z\",\"EndDate\":\"2020-12-31T00:00:00Z\",\"Status\":\"active\"},\"Teacher\":{\"ID\":1,\"FirstName\":\"Anh\",\"LastName\":\"Le\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.CourseFilterModel{TermID: "1"},
+			mockServiceResult: []*models.CourseModel{
+				{
+					ID:        1,
+					Name:      "Math",
+					StartTime: "2020-11-02T00:00:00Z",
+					EndTime:   "2020-11-03T00:00:00Z",
+					Term: &models.TermModel{
+						ID:        1,
+						Name:      "Fall 2020",
+						StartDate: "2020-08-15T00:00:00Z",
+						EndDate:   "2020-12-31T00:00:00Z",
+						Status:    models.TermStatusActive,
+					},
+					Teacher: &models.TeacherModel{
+						ID:          1,
+						FirstName:   "Anh",
+						LastName:    "Le",
+						DateOfBirth: "1998-11-02T00:00:00Z",
+					},
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("GetCourses", testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/courses"+testCase.query, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.GetCourses)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
 func Test_DeleteCourse(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -313,6 +532,7 @@ func Test_UpdateCourse(t *testing.T) {
 				"name":      "Math",
 				"startTime": "2020-11-02T00:00:00Z",
 				"endTime":   "2020-11-03T00:00:00Z",
+				"termID":    1,
 				"teacherID": 1,
 			},
 			expectedResponseBody: "update course fail\n",
@@ -322,6 +542,9 @@ func Test_UpdateCourse(t *testing.T) {
 				Name:      "Math",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -335,6 +558,7 @@ func Test_UpdateCourse(t *testing.T) {
 				"name":      "Physics",
 				"startTime": "2020-11-02T00:00:00Z",
 				"endTime":   "2020-11-03T00:00:00Z",
+				"termID":    1,
 				"teacherID": 1,
 			},
 			expectedResponseBody: "{\"success\":true}\n",
@@ -344,6 +568,9 @@ func Test_UpdateCourse(t *testing.T) {
 				Name:      "Physics",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -385,3 +612,1060 @@ func Test_UpdateCourse(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetCourseStudents(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		query                string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.RosterFilterModel
+		mockServiceResult    []*repositories.StudentEntity
+		mockServiceTotal     int
+		mockServiceError     error
+	}{
+		{
+			name:                 "validate limit fail",
+			paramID:              "1",
+			query:                "?limit=500",
+			expectedResponseBody: "limit must be a number between 1 and 100\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "validate sort field fail",
+			paramID:              "1",
+			query:                "?sortBy=dateOfBirth",
+			expectedResponseBody: "invalid sort field: dateOfBirth\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "course not found",
+			paramID:              "3",
+			query:                "",
+			expectedResponseBody: "course not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput: &models.RosterFilterModel{
+				Limit:  20,
+				SortBy: "lastName",
+				Order:  "asc",
+			},
+			mockServiceResult: nil,
+			mockServiceError:  models.ErrCourseNotFound,
+		},
+		{
+			name:                 "get course students successfully",
+			paramID:              "1",
+			query:                "?limit=1&offset=1&sortBy=studentID&order=desc",
+			expectedResponseBody: "{\"success\":true,\"students\":[{\"id\":1,\"studentID\":\"123456\",\"firstName\":\"Anh\",\"lastName\":\"Le\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\"}],\"total\":2,\"limit\":1,\"offset\":1}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.RosterFilterModel{
+				Limit:  1,
+				Offset: 1,
+				SortBy: "studentID",
+				Order:  "desc",
+			},
+			mockServiceResult: []*repositories.StudentEntity{
+				{
+					ID:          1,
+					StudentID:   "123456",
+					FirstName:   "Anh",
+					LastName:    "Le",
+					DateOfBirth: "1998-11-02T00:00:00Z",
+				},
+			},
+			mockServiceTotal: 2,
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("GetCourseStudents", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceTotal, testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/courses/course/{id}/students"+testCase.query, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.GetCourseStudents)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetPrerequisites(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceResult    []*models.CourseModel
+		mockServiceError     error
+	}{
+		{
+			name:                 "course not found",
+			paramID:              "3",
+			expectedResponseBody: "course not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrCourseNotFound,
+		},
+		{
+			name:                 "get prerequisites successfully",
+			paramID:              "2",
+			expectedResponseBody: "{\"success\":true,\"prerequisites\":[{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":{\"ID\":1,\"FirstName\":\"Anh\",\"LastName\":\"Le\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult: []*models.CourseModel{
+				{
+					ID:        1,
+					Name:      "Math",
+					StartTime: "2020-11-02T00:00:00Z",
+					EndTime:   "2020-11-03T00:00:00Z",
+					Teacher: &models.TeacherModel{
+						ID:          1,
+						FirstName:   "Anh",
+						LastName:    "Le",
+						DateOfBirth: "1998-11-02T00:00:00Z",
+					},
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("GetPrerequisites", testCase.paramID).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/courses/course/{id}/prerequisites", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.GetPrerequisites)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_AddPrerequisite(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramPrerequisiteID  string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceError     error
+	}{
+		{
+			name:                 "course not found",
+			paramID:              "3",
+			paramPrerequisiteID:  "1",
+			expectedResponseBody: "course not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceError:     models.ErrCourseNotFound,
+		},
+		{
+			name:                 "prerequisite creates a cycle",
+			paramID:              "1",
+			paramPrerequisiteID:  "2",
+			expectedResponseBody: "prerequisite would create a cycle\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceError:     models.ErrPrerequisiteCycle,
+		},
+		{
+			name:                 "add prerequisite successfully",
+			paramID:              "2",
+			paramPrerequisiteID:  "1",
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("AddPrerequisite", testCase.paramID, testCase.paramPrerequisiteID).Return(testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodPost, "/courses/course/{id}/prerequisites/{prerequisiteId}", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("prerequisiteId", testCase.paramPrerequisiteID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.AddPrerequisite)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_DeletePrerequisite(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramPrerequisiteID  string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceError     error
+	}{
+		{
+			name:                 "prerequisite not found",
+			paramID:              "1",
+			paramPrerequisiteID:  "2",
+			expectedResponseBody: "prerequisite not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceError:     models.ErrPrerequisiteNotFound,
+		},
+		{
+			name:                 "delete prerequisite successfully",
+			paramID:              "2",
+			paramPrerequisiteID:  "1",
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("DeletePrerequisite", testCase.paramID, testCase.paramPrerequisiteID).Return(testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodDelete, "/courses/course/{id}/prerequisites/{prerequisiteId}", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("prerequisiteId", testCase.paramPrerequisiteID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.DeletePrerequisite)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_BulkEnrollCourse(t *testing.T) {
+	creditLimit := &models.CreditLimitExceededError{Term: "Fall 2020", CurrentCredits: 18, CourseCredits: 3, Limit: 18}
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.BulkEnrollmentModel
+		mockServiceResult    []*repositories.BulkEnrollmentResultEntity
+		mockServiceError     error
+	}{
+		{
+			name:    "validate request body fail",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"mode": models.BulkEnrollmentBestEffort,
+			},
+			expectedResponseBody: "student ids or student codes are required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "invalid mode",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"studentIDs": []int{1},
+				"mode":       "some",
+			},
+			expectedResponseBody: "invalid bulk enrollment mode: some\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "course not found",
+			paramID: "2",
+			requestBody: map[string]interface{}{
+				"studentIDs": []int{1},
+			},
+			expectedResponseBody: "course not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput: &models.BulkEnrollmentModel{
+				StudentIDs: []int{1},
+				Mode:       models.BulkEnrollmentAllOrNothing,
+				Options:    &models.EnrollmentOptionsModel{},
+			},
+			mockServiceResult: nil,
+			mockServiceError:  models.ErrCourseNotFound,
+		},
+		{
+			name:    "all or nothing fail",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"studentIDs":   []int{2},
+				"studentCodes": []string{"123456"},
+			},
+			expectedResponseBody: "{\"success\":false,\"mode\":\"all-or-nothing\",\"results\":[{\"studentID\":2,\"success\":false,\"error\":\"not enrolled because another student in the batch failed\",\"code\":\"BULK_ENROLLMENT_ROLLED_BACK\"},{\"studentID\":1,\"studentCode\":\"123456\",\"success\":false,\"error\":\"student is already enrolled in this course\",\"code\":\"ALREADY_ENROLLED\"}]}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput: &models.BulkEnrollmentModel{
+				StudentIDs:   []int{2},
+				StudentCodes: []string{"123456"},
+				Mode:         models.BulkEnrollmentAllOrNothing,
+				Options:      &models.EnrollmentOptionsModel{},
+			},
+			mockServiceResult: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 2, Error: models.ErrBulkEnrollmentRolledBack.Error(), Code: models.EnrollmentRolledBack},
+				{StudentID: 1, StudentCode: "123456", Error: models.ErrAlreadyEnrolled.Error(), Code: models.EnrollmentAlreadyEnrolled},
+			},
+			mockServiceError: nil,
+		},
+		{
+			name:    "schedule conflict overridden without overridden by",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"studentIDs":               []int{2, 1},
+				"overrideScheduleConflict": true,
+			},
+			expectedResponseBody: "overridden by is required to override the schedule conflict check\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "best effort partially enrolled",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"studentIDs":               []int{2, 1},
+				"mode":                     models.BulkEnrollmentBestEffort,
+				"overrideScheduleConflict": true,
+				"overriddenBy":             "registrar",
+			},
+			expectedResponseBody: "{\"success\":false,\"mode\":\"best-effort\",\"results\":[{\"studentID\":2,\"success\":true,\"enrollment\":{\"id\":3,\"studentID\":2,\"courseID\":1,\"status\":\"active\"}},{\"studentID\":1,\"success\":false,\"error\":\"enrolling would exceed the credit limit for Fall 2020: current load 18, course credits 3, limit 18\",\"code\":\"CREDIT_LIMIT_EXCEEDED\",\"details\":{\"courseCredits\":3,\"currentCredits\":18,\"limit\":18,\"term\":\"Fall 2020\"}}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.BulkEnrollmentModel{
+				StudentIDs: []int{2, 1},
+				Mode:       models.BulkEnrollmentBestEffort,
+				Options: &models.EnrollmentOptionsModel{
+					OverrideScheduleConflict: true,
+					OverriddenBy:             "registrar",
+				},
+			},
+			mockServiceResult: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 2, Success: true, Enrollment: &repositories.EnrollmentEntity{ID: 3, StudentID: 2, CourseID: 1, Status: "active"}},
+				{StudentID: 1, Error: creditLimit.Error(), Code: models.EnrollmentCreditLimitExceeded, Details: creditLimit.Details()},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("BulkEnrollCourse", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPost, "/courses/course/{id}/enrollments:bulk", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.BulkEnrollCourse)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_SetRegistrationOverride(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramStudentID       string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     string
+		mockServiceError     error
+	}{
+		{
+			name:                 "validate request body fail",
+			paramID:              "1",
+			paramStudentID:       "1",
+			requestBody:          map[string]interface{}{},
+			expectedResponseBody: "granted by is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:           "student not found",
+			paramID:        "1",
+			paramStudentID: "4",
+			requestBody: map[string]interface{}{
+				"grantedBy": "admin",
+			},
+			expectedResponseBody: "student not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     "admin",
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:           "set registration override successfully",
+			paramID:        "1",
+			paramStudentID: "1",
+			requestBody: map[string]interface{}{
+				"grantedBy": "admin",
+			},
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     "admin",
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("SetRegistrationOverride", testCase.paramID, testCase.paramStudentID, testCase.mockServiceInput).Return(testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/courses/course/{id}/registration-overrides/{studentId}", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("studentId", testCase.paramStudentID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.SetRegistrationOverride)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_DeleteRegistrationOverride(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramStudentID       string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceError     error
+	}{
+		{
+			name:                 "registration override not found",
+			paramID:              "1",
+			paramStudentID:       "2",
+			expectedResponseBody: "registration override not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceError:     models.ErrRegistrationOverrideNotFound,
+		},
+		{
+			name:                 "delete registration override successfully",
+			paramID:              "1",
+			paramStudentID:       "1",
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("DeleteRegistrationOverride", testCase.paramID, testCase.paramStudentID).Return(testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodDelete, "/courses/course/{id}/registration-overrides/{studentId}", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("studentId", testCase.paramStudentID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.DeleteRegistrationOverride)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_RecordCourseGrade(t *testing.T) {
+	score := 87.5
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramStudentID       string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.RecordGradeModel
+		mockServiceResult    *repositories.EnrollmentEntity
+		mockServiceError     error
+	}{
+		{
+			name:                 "validate request body fail",
+			paramID:              "1",
+			paramStudentID:       "1",
+			requestBody:          map[string]interface{}{},
+			expectedResponseBody: "either a letter or a score is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:           "student not enrolled",
+			paramID:        "1",
+			paramStudentID: "3",
+			requestBody: map[string]interface{}{
+				"score": 87.5,
+			},
+			expectedResponseBody: "student is not enrolled in this course\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     &models.RecordGradeModel{Score: &score},
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrNotEnrolled,
+		},
+		{
+			name:           "record grade successfully",
+			paramID:        "1",
+			paramStudentID: "1",
+			requestBody: map[string]interface{}{
+				"score": 87.5,
+			},
+			expectedResponseBody: "{\"success\":true,\"enrollment\":{\"id\":1,\"studentID\":1,\"courseID\":1,\"status\":\"active\",\"gradeScore\":87.5}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.RecordGradeModel{Score: &score},
+			mockServiceResult: &repositories.EnrollmentEntity{
+				ID:         1,
+				StudentID:  1,
+				CourseID:   1,
+				Status:     "active",
+				GradeScore: &score,
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("RecordGrade", testCase.paramID, testCase.paramStudentID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/courses/course/{id}/students/{studentId}/grade", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("studentId", testCase.paramStudentID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.RecordGrade)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_SetMeetingPattern(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.MeetingPatternModel
+		mockServiceResult    *repositories.MeetingPatternEntity
+		mockServiceError     error
+	}{
+		{
+			name:    "invalid day of week",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"daysOfWeek":      []string{"monday", "funday"},
+				"startTime":       "09:00",
+				"durationMinutes": 90,
+				"timeZone":        "UTC",
+			},
+			expectedResponseBody: "invalid day of week: funday\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "unknown time zone",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"daysOfWeek":      []string{"monday"},
+				"startTime":       "09:00",
+				"durationMinutes": 90,
+				"timeZone":        "Mars/Olympus_Mons",
+			},
+			expectedResponseBody: "unknown time zone: Mars/Olympus_Mons\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "no sessions between the course dates",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"daysOfWeek":      []string{"saturday"},
+				"startTime":       "09:00",
+				"durationMinutes": 90,
+				"timeZone":        "UTC",
+			},
+			expectedResponseBody: "meeting pattern has no sessions between the course dates\n",
+			expectedStatus:       http.StatusBadRequest,
+			mockServiceInput: &models.MeetingPatternModel{
+				DaysOfWeek:      []string{"saturday"},
+				StartTime:       "09:00",
+				DurationMinutes: 90,
+				TimeZone:        "UTC",
+			},
+			mockServiceResult: nil,
+			mockServiceError:  models.ErrNoSessions,
+		},
+		{
+			name:    "set meeting pattern successfully",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"daysOfWeek":      []string{"monday"},
+				"startTime":       "09:00",
+				"durationMinutes": 90,
+				"timeZone":        "UTC",
+				"excludedDates":   []string{"2020-11-09"},
+			},
+			expectedResponseBody: "{\"success\":true,\"meetingPattern\":{\"courseID\":1,\"daysOfWeek\":[\"monday\"],\"startTime\":\"09:00\",\"durationMinutes\":90,\"timeZone\":\"UTC\",\"excludedDates\":[\"2020-11-09\"],\"sessions\":[{\"id\":1,\"courseID\":1,\"date\":\"2020-11-02\",\"startTime\":\"2020-11-02T09:00:00Z\",\"endTime\":\"2020-11-02T10:30:00Z\"}]}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.MeetingPatternModel{
+				DaysOfWeek:      []string{"monday"},
+				StartTime:       "09:00",
+				DurationMinutes: 90,
+				TimeZone:        "UTC",
+				ExcludedDates:   []string{"2020-11-09"},
+			},
+			mockServiceResult: &repositories.MeetingPatternEntity{
+				CourseID:        1,
+				DaysOfWeek:      []string{"monday"},
+				StartTime:       "09:00",
+				DurationMinutes: 90,
+				TimeZone:        "UTC",
+				ExcludedDates:   []string{"2020-11-09"},
+				Sessions: []*repositories.SessionEntity{
+					{ID: 1, CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("SetMeetingPattern", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/courses/course/{id}/meeting-pattern", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.SetMeetingPattern)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_SetCourseTeacher(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramTeacherID       string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     string
+		mockServiceError     error
+	}{
+		{
+			name:                 "role is required",
+			paramID:              "1",
+			paramTeacherID:       "2",
+			requestBody:          map[string]interface{}{},
+			expectedResponseBody: "role is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:           "invalid role",
+			paramID:        "1",
+			paramTeacherID: "2",
+			requestBody: map[string]interface{}{
+				"role": "tutor",
+			},
+			expectedResponseBody: "invalid role: tutor\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:           "primary teacher",
+			paramID:        "1",
+			paramTeacherID: "1",
+			requestBody: map[string]interface{}{
+				"role": "assistant",
+			},
+			expectedResponseBody: "teacher is the primary teacher of this course, assign the course to another teacher instead\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     "assistant",
+			mockServiceError:     models.ErrPrimaryTeacher,
+		},
+		{
+			name:           "teacher is archived",
+			paramID:        "1",
+			paramTeacherID: "3",
+			requestBody: map[string]interface{}{
+				"role": "grader",
+			},
+			expectedResponseBody: "teacher is archived\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     "grader",
+			mockServiceError:     models.ErrTeacherArchived,
+		},
+		{
+			name:           "course exceeds the workload of the teacher",
+			paramID:        "1",
+			paramTeacherID: "2",
+			requestBody: map[string]interface{}{
+				"role": "assistant",
+			},
+			expectedResponseBody: "{\"success\":false,\"error\":\"assigning the course would exceed the teacher's course limit for Fall 2020: current 4, course 1, limit 4\",\"details\":{\"code\":\"TEACHER_COURSE_LIMIT\",\"course\":1,\"current\":4,\"limit\":4,\"term\":\"Fall 2020\"}}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     "assistant",
+			mockServiceError: &models.TeacherWorkloadExceededError{
+				Code: models.TeacherCourseLimit, Term: "Fall 2020", Current: 4, Course: 1, Limit: 4,
+			},
+		},
+		{
+			name:           "set course teacher successfully",
+			paramID:        "1",
+			paramTeacherID: "2",
+			requestBody: map[string]interface{}{
+				"role": "assistant",
+			},
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     "assistant",
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("SetCourseTeacher", testCase.paramID, testCase.paramTeacherID, testCase.mockServiceInput).Return(testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/courses/course/{id}/teachers/{teacherId}", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("teacherId", testCase.paramTeacherID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.SetCourseTeacher)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetCoursesV2(t *testing.T) {
+	enrolled := 3
+	testCases := []struct {
+		name                 string
+		query                string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.CourseFilterModel
+		mockServiceResult    []*models.CourseModel
+		mockServiceError     error
+	}{
+		{
+			name:                 "teacher not found",
+			query:                "?teacherId=9",
+			expectedResponseBody: "teacher not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     &models.CourseFilterModel{TeacherID: "9"},
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrTeacherNotFound,
+		},
+		{
+			name:                 "get courses with their teachers successfully",
+			query:                "?termId=1",
+			expectedResponseBody: "{\"success\":true,\"courses\":[{\"id\":1,\"name\":\"Math\",\"startTime\":\"2020-11-02T00:00:00Z\",\"endTime\":\"2020-11-03T00:00:00Z\",\"capacity\":null,\"credits\":0,\"enrolled\":3,\"registrationOpensAt\":null,\"registrationClosesAt\":null,\"term\":{\"id\":1,\"name\":\"Fall 2020\",\"startDate\":\"2020-08-15T00:00:00Z\",\"endDate\":\"2020-12-31T00:00:00Z\",\"status\":\"active\"},\"teachers\":[{\"id\":1,\"firstName\":\"Anh\",\"lastName\":\"Le\",\"role\":\"lead\"},{\"id\":2,\"firstName\":\"Binh\",\"lastName\":\"Tran\",\"role\":\"assistant\"}],\"departmentID\":null}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.CourseFilterModel{TermID: "1"},
+			mockServiceResult: []*models.CourseModel{
+				{
+					ID:        1,
+					Name:      "Math",
+					StartTime: "2020-11-02T00:00:00Z",
+					EndTime:   "2020-11-03T00:00:00Z",
+					Enrolled:  &enrolled,
+					Term: &models.TermModel{
+						ID:        1,
+						Name:      "Fall 2020",
+						StartDate: "2020-08-15T00:00:00Z",
+						EndDate:   "2020-12-31T00:00:00Z",
+						Status:    models.TermStatusActive,
+					},
+					Teacher: &models.TeacherModel{ID: 1, FirstName: "Anh", LastName: "Le"},
+					Teachers: []*models.CourseTeacherModel{
+						{
+							Teacher: &models.TeacherModel{ID: 1, FirstName: "Anh", LastName: "Le"},
+							Role:    models.CourseTeacherRoleLead,
+						},
+						{
+							Teacher: &models.TeacherModel{ID: 2, FirstName: "Binh", LastName: "Tran"},
+							Role:    models.CourseTeacherRoleAssistant,
+						},
+					},
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("GetCoursesWithTeachers", testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/v2/courses"+testCase.query, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.GetCoursesV2)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_BookSessionRoom(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramDate            string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     int
+		mockServiceError     error
+	}{
+		{
+			name:                 "invalid session date",
+			paramDate:            "11/09/2020",
+			requestBody:          map[string]interface{}{"roomID": 2},
+			expectedResponseBody: "session date must be a YYYY-MM-DD date\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "room id is required",
+			paramDate:            "2020-11-09",
+			requestBody:          map[string]interface{}{},
+			expectedResponseBody: "room id is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "room is too small",
+			paramDate:            "2020-11-09",
+			requestBody:          map[string]interface{}{"roomID": 1},
+			expectedResponseBody: "{\"success\":false,\"error\":\"room seats 10 but the course has 25 students enrolled\",\"details\":{\"capacity\":10,\"enrolled\":25}}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     1,
+			mockServiceError:     &models.RoomCapacityExceededError{Capacity: 10, Enrolled: 25},
+		},
+		{
+			name:                 "room is already booked",
+			paramDate:            "2020-11-09",
+			requestBody:          map[string]interface{}{"roomID": 2},
+			expectedResponseBody: "{\"success\":false,\"error\":\"room is already booked at that time: Physics\",\"details\":[{\"ID\":3,\"Name\":\"Physics\",\"StartTime\":\"\",\"EndTime\":\"\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":null}]}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     2,
+			mockServiceError:     &models.RoomConflictError{Courses: []*models.CourseModel{{ID: 3, Name: "Physics"}}},
+		},
+		{
+			name:                 "book session room successfully",
+			paramDate:            "2020-11-09",
+			requestBody:          map[string]interface{}{"roomID": 2},
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     2,
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockCourseService)
+			mockService.On("BookRoom", "1", testCase.paramDate, testCase.mockServiceInput).Return(testCase.mockServiceError)
+
+			courseHandler := CourseHandlers{
+				CourseServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/courses/course/{id}/sessions/{date}/room", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", "1")
+			chiCtx.URLParams.Add("date", testCase.paramDate)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(courseHandler.BookSessionRoom)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"student_rest/models"
+
+	"github.com/go-chi/chi"
+	"student_rest/services"
+)
+
+type DepartmentHandlers struct {
+	services.DepartmentServices
+}
+
+func (_self DepartmentHandlers) CreateDepartment(w http.ResponseWriter, r *http.Request) {
+	var department DepartmentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&department); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := department.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedDepartment := transformDepartmentRequestToDepartmentModel(department)
+	result, err := _self.DepartmentServices.CreateDepartment(&convertedDepartment)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(DepartmentResponse{
+		Success:    true,
+		Department: result,
+	})
+}
+
+func transformDepartmentRequestToDepartmentModel(request DepartmentRequest) models.DepartmentModel {
+	return models.DepartmentModel{
+		Code: request.Code,
+		Name: request.Name,
+	}
+}
+
+func (_self DepartmentHandlers) GetDepartments(w http.ResponseWriter, r *http.Request) {
+	result, err := _self.DepartmentServices.GetDepartments()
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(DepartmentsResponse{
+		Success:     true,
+		Departments: result,
+	})
+}
+
+func (_self DepartmentHandlers) GetDepartmentByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.DepartmentServices.GetDepartmentByID(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(DepartmentResponse{
+		Success:    true,
+		Department: result,
+	})
+}
+
+func (_self DepartmentHandlers) UpdateDepartment(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var department DepartmentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&department); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := department.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedDepartment := transformDepartmentRequestToDepartmentModel(department)
+	if err := _self.DepartmentServices.UpdateDepartment(id, &convertedDepartment); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self DepartmentHandlers) DeleteDepartment(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	if err := _self.DepartmentServices.DeleteDepartment(id); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockDepartmentService struct {
+	mock.Mock
+}
+
+func (m *MockDepartmentService) CreateDepartment(department *models.DepartmentModel) (*repositories.DepartmentEntity, error) {
+	returnArgs := m.Called(department)
+	return returnArgs.Get(0).(*repositories.DepartmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockDepartmentService) GetDepartments() ([]*repositories.DepartmentEntity, error) {
+	returnArgs := m.Called()
+	return returnArgs.Get(0).([]*repositories.DepartmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockDepartmentService) GetDepartmentByID(id string) (*repositories.DepartmentEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.DepartmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockDepartmentService) UpdateDepartment(id string, department *models.DepartmentModel) error {
+	returnArgs := m.Called(id, department)
+	return returnArgs.Error(0)
+}
+
+func (m *MockDepartmentService) DeleteDepartment(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+func Test_CreateDepartment(t *testing.T) {
+	var noDepartment *repositories.DepartmentEntity
+
+	testCases := []struct {
+		name                 string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.DepartmentModel
+		mockServiceResult    *repositories.DepartmentEntity
+		mockServiceError     error
+	}{
+		{
+			name: "validate request body fail",
+			requestBody: map[string]interface{}{
+				"code": "MATH",
+			},
+			expectedResponseBody: "department name is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name: "department already exists",
+			requestBody: map[string]interface{}{
+				"code": "MATH",
+				"name": "Mathematics",
+			},
+			expectedResponseBody: "a department with this code already exists\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     &models.DepartmentModel{Code: "MATH", Name: "Mathematics"},
+			mockServiceResult:    noDepartment,
+			mockServiceError:     models.ErrDepartmentExists,
+		},
+		{
+			name: "create department successfully",
+			requestBody: map[string]interface{}{
+				"code": "MATH",
+				"name": "Mathematics",
+			},
+			expectedResponseBody: "{\"success\":true,\"department\":{\"id\":1,\"code\":\"MATH\",\"name\":\"Mathematics\"}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.DepartmentModel{Code: "MATH", Name: "Mathematics"},
+			mockServiceResult:    &repositories.DepartmentEntity{ID: 1, Code: "MATH", Name: "Mathematics"},
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockDepartmentService)
+			mockService.On("CreateDepartment", testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			departmentHandler := DepartmentHandlers{
+				DepartmentServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPost, "/departments", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(departmentHandler.CreateDepartment)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_DeleteDepartment(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceError     error
+	}{
+		{
+			name:                 "department not found",
+			paramID:              "9",
+			expectedResponseBody: "department not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceError:     models.ErrDepartmentNotFound,
+		},
+		{
+			name:                 "department still in use",
+			paramID:              "1",
+			expectedResponseBody: "department still has programs, teachers or courses\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceError:     models.ErrDepartmentInUse,
+		},
+		{
+			name:                 "delete department successfully",
+			paramID:              "3",
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockDepartmentService)
+			mockService.On("DeleteDepartment", testCase.paramID).Return(testCase.mockServiceError)
+
+			departmentHandler := DepartmentHandlers{
+				DepartmentServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodDelete, "/departments/department/{id}", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(departmentHandler.DeleteDepartment)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
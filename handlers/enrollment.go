@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"student_rest/models"
+
+	"github.com/go-chi/chi"
+	"student_rest/services"
+)
+
+type EnrollmentHandlers struct {
+	services.EnrollmentServices
+}
+
+func (_self EnrollmentHandlers) GetEnrollmentByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.EnrollmentServices.GetEnrollmentByID(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(EnrollmentResponse{
+		Success:    true,
+		Enrollment: result,
+	})
+}
+
+// UpdateEnrollmentStatus moves the enrollment to a new status, illegal transitions are refused with 409
+func (_self EnrollmentHandlers) UpdateEnrollmentStatus(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var statusChange EnrollmentStatusRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&statusChange); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := statusChange.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.EnrollmentServices.UpdateEnrollmentStatus(id, &models.EnrollmentStatusChangeModel{
+		Status:      statusChange.Status,
+		Reason:      statusChange.Reason,
+		PerformedBy: statusChange.PerformedBy,
+	})
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(EnrollmentResponse{
+		Success:    true,
+		Enrollment: result,
+	})
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockEnrollmentService struct {
+	mock.Mock
+}
+
+func (m *MockEnrollmentService) GetEnrollmentByID(id string) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockEnrollmentService) UpdateEnrollmentStatus(id string, change *models.EnrollmentStatusChangeModel) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(id, change)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func Test_GetEnrollmentByID(t *testing.T) {
+	active := "active"
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceResult    *repositories.EnrollmentEntity
+		mockServiceError     error
+	}{
+		{
+			name:                 "enrollment not found",
+			paramID:              "3",
+			expectedResponseBody: "enrollment not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrEnrollmentNotFound,
+		},
+		{
+			name:                 "get enrollment by id successfully",
+			paramID:              "1",
+			expectedResponseBody: "{\"success\":true,\"enrollment\":{\"id\":1,\"studentID\":1,\"courseID\":1,\"status\":\"completed\",\"history\":[{\"id\":1,\"fromStatus\":null,\"toStatus\":\"active\",\"changedAt\":\"2020-11-02T00:00:00Z\"},{\"id\":2,\"fromStatus\":\"active\",\"toStatus\":\"completed\",\"changedAt\":\"2020-11-03T00:00:00Z\"}]}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult: &repositories.EnrollmentEntity{
+				ID:        1,
+				StudentID: 1,
+				CourseID:  1,
+				Status:    "completed",
+				History: []*repositories.EnrollmentStatusChangeEntity{
+					{ID: 1, ToStatus: "active", ChangedAt: "2020-11-02T00:00:00Z"},
+					{ID: 2, FromStatus: &active, ToStatus: "completed", ChangedAt: "2020-11-03T00:00:00Z"},
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockEnrollmentService)
+			mockService.On("GetEnrollmentByID", testCase.paramID).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			enrollmentHandler := EnrollmentHandlers{
+				EnrollmentServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/enrollments/{id}", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(enrollmentHandler.GetEnrollmentByID)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_UpdateEnrollmentStatus(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.EnrollmentStatusChangeModel
+		mockServiceResult    *repositories.EnrollmentEntity
+		mockServiceError     error
+	}{
+		{
+			name:    "invalid status",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"status": "dropped",
+			},
+			expectedResponseBody: "invalid enrollment status: dropped\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "withdrawal without reason",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"status": "withdrawn",
+			},
+			expectedResponseBody: "withdrawal reason is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "illegal transition",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"status": "active",
+			},
+			expectedResponseBody: "{\"success\":false,\"error\":\"cannot change enrollment status from completed to active, completed is a final status\",\"details\":{\"allowed\":[],\"from\":\"completed\",\"to\":\"active\"}}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput: &models.EnrollmentStatusChangeModel{
+				Status: "active",
+			},
+			mockServiceResult: nil,
+			mockServiceError: &models.InvalidStatusTransitionError{
+				From:    "completed",
+				To:      "active",
+				Allowed: []string{},
+			},
+		},
+		{
+			name:    "update enrollment status successfully",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"status": "completed",
+			},
+			expectedResponseBody: "{\"success\":true,\"enrollment\":{\"id\":1,\"studentID\":1,\"courseID\":1,\"status\":\"completed\"}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.EnrollmentStatusChangeModel{
+				Status: "completed",
+			},
+			mockServiceResult: &repositories.EnrollmentEntity{
+				ID:        1,
+				StudentID: 1,
+				CourseID:  1,
+				Status:    "completed",
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockEnrollmentService)
+			mockService.On("UpdateEnrollmentStatus", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			enrollmentHandler := EnrollmentHandlers{
+				EnrollmentServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPatch, "/enrollments/{id}/status", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(enrollmentHandler.UpdateEnrollmentStatus)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"student_rest/models"
+)
+
+// detailedError is implemented by service errors that carry structured details for the client
+type detailedError interface {
+	error
+	Details() interface{}
+}
+
+// errorStatus maps errors returned by the services to HTTP status codes
+func errorStatus(err error) int {
+	var scheduleConflict *models.ScheduleConflictError
+	var missingPrerequisites *models.MissingPrerequisitesError
+	var invalidTransition *models.InvalidStatusTransitionError
+	var registrationWindow *models.RegistrationWindowError
+	var creditLimit *models.CreditLimitExceededError
+	var courseOutsideTerm *models.CourseOutsideTermError
+	var studentsNotEnrolled *models.StudentsNotEnrolledError
+	var teacherConflict *models.TeacherScheduleConflictError
+	var teacherWorkload *models.TeacherWorkloadExceededError
+	var teacherHasCourses *models.TeacherHasCoursesError
+	var roomConflict *models.RoomConflictError
+	var roomCapacity *models.RoomCapacityExceededError
+	var roomTooSmall *models.RoomTooSmallError
+
+	switch {
+	case errors.Is(err, models.ErrInvalidGrade), errors.As(err, &courseOutsideTerm),
+		errors.Is(err, models.ErrSessionOutsideCourse), errors.Is(err, models.ErrSessionNotScheduled),
+		errors.Is(err, models.ErrNoSessions):
+		return http.StatusBadRequest
+	case errors.Is(err, models.ErrStudentNotFound), errors.Is(err, models.ErrCourseNotFound),
+		errors.Is(err, models.ErrTeacherNotFound), errors.Is(err, models.ErrReassignTeacherNotFound),
+		errors.Is(err, models.ErrCourseTeacherNotFound), errors.Is(err, models.ErrRoomNotFound),
+		errors.Is(err, models.ErrRoomBookingNotFound), errors.Is(err, models.ErrDepartmentNotFound),
+		errors.Is(err, models.ErrProgramNotFound), errors.Is(err, models.ErrProgramRequirementNotFound),
+		errors.Is(err, models.ErrNotEnrolled), errors.Is(err, models.ErrPrerequisiteNotFound),
+		errors.Is(err, models.ErrEnrollmentNotFound), errors.Is(err, models.ErrRegistrationOverrideNotFound),
+		errors.Is(err, models.ErrCreditOverrideNotFound), errors.Is(err, models.ErrTermNotFound),
+		errors.As(err, &studentsNotEnrolled), errors.Is(err, models.ErrMeetingPatternNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, models.ErrAlreadyEnrolled), errors.Is(err, models.ErrAlreadyWaitlisted),
+		errors.As(err, &scheduleConflict), errors.Is(err, models.ErrPrerequisiteExists),
+		errors.Is(err, models.ErrPrerequisiteCycle), errors.As(err, &missingPrerequisites),
+		errors.As(err, &invalidTransition), errors.Is(err, models.ErrEnrollmentStatusChanged),
+		errors.Is(err, models.ErrCourseFull), errors.As(err, &creditLimit),
+		errors.Is(err, models.ErrTermHasCourses), errors.Is(err, models.ErrTermExcludesCourses),
+		errors.As(err, &teacherConflict), errors.As(err, &teacherWorkload),
+		errors.As(err, &teacherHasCourses), errors.Is(err, models.ErrTeacherArchived),
+		errors.Is(err, models.ErrPrimaryTeacher), errors.Is(err, models.ErrRoomExists),
+		errors.Is(err, models.ErrRoomHasBookings), errors.As(err, &roomConflict), errors.As(err, &roomCapacity),
+		errors.As(err, &roomTooSmall), errors.Is(err, models.ErrDepartmentExists),
+		errors.Is(err, models.ErrDepartmentInUse),
+		errors.Is(err, models.ErrProgramExists), errors.Is(err, models.ErrProgramHasStudents),
+		errors.Is(err, models.ErrStudentHasNoProgram):
+		return http.StatusConflict
+	case errors.As(err, &registrationWindow):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeError writes the error as plain text, or as an ErrorResponse when it carries details
+func writeError(w http.ResponseWriter, err error) {
+	var detailed detailedError
+	if !errors.As(err, &detailed) {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(errorStatus(err))
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Success: false,
+		Error:   err.Error(),
+		Details: detailed.Details(),
+	})
+}
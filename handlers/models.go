@@ -2,14 +2,19 @@ package handlers
 
 import (
 	"errors"
+	"strconv"
+	"strings"
 	"student_rest/models"
 	"student_rest/repositories"
+	"time"
 )
 
 type StudentRequest struct {
 	FirstName   string `json:"firstName"`
 	LastName    string `json:"lastName"`
 	DateOfBirth string `json:"dateOfBirth"`
+
+	ProgramID *int `json:"programID"`
 }
 
 func (_self StudentRequest) validation() error {
@@ -35,6 +40,8 @@ type TeacherRequest struct {
 	FirstName   string `json:"firstName"`
 	LastName    string `json:"lastName"`
 	DateOfBirth string `json:"dateOfBirth"`
+
+	DepartmentID *int `json:"departmentID"`
 }
 
 func (_self TeacherRequest) validation() error {
@@ -56,16 +63,83 @@ type CourseRequest struct {
 	Name      string `json:"name"`
 	StartTime string `json:"startTime"`
 	EndTime   string `json:"endTime"`
-	TeacherID int    `json:"teacherID"`
+	Capacity  *int   `json:"capacity"`
+	Credits   int    `json:"credits"`
+
+	RegistrationOpensAt  *string `json:"registrationOpensAt"`
+	RegistrationClosesAt *string `json:"registrationClosesAt"`
+
+	TermID       int  `json:"termID"`
+	TeacherID    int  `json:"teacherID"`
+	DepartmentID *int `json:"departmentID"`
 }
 
 func (_self CourseRequest) validation() error {
 	if _self.Name == "" {
 		return errors.New("course name is required")
 	}
+
+	if _self.Capacity != nil && *_self.Capacity <= 0 {
+		return errors.New("capacity must be greater than 0")
+	}
+	if _self.Credits < 0 {
+		return errors.New("credits must not be negative")
+	}
+	if err := validateRegistrationWindow(_self.RegistrationOpensAt, _self.RegistrationClosesAt); err != nil {
+		return err
+	}
+	if _self.TermID == 0 {
+		return errors.New("term id is required")
+	}
+	start, err := time.Parse(time.RFC3339, _self.StartTime)
+	if err != nil {
+		return errors.New("start time must be an RFC3339 timestamp")
+	}
+	end, err := time.Parse(time.RFC3339, _self.EndTime)
+	if err != nil {
+		return errors.New("end time must be an RFC3339 timestamp")
+	}
+	if !start.Before(end) {
+		return errors.New("course must start before it ends")
+	}
+	return nil
+}
+
+func validateRegistrationWindow(opensAt *string, closesAt *string) error {
+	var opens, closes time.Time
+	var err error
+	if opensAt != nil {
+		if opens, err = time.Parse(time.RFC3339, *opensAt); err != nil {
+			return errors.New("registration open time must be an RFC3339 timestamp")
+		}
+	}
+	if closesAt != nil {
+		if closes, err = time.Parse(time.RFC3339, *closesAt); err != nil {
+			return errors.New("registration close time must be an RFC3339 timestamp")
+		}
+	}
+	if opensAt != nil && closesAt != nil && !opens.Before(closes) {
+		return errors.New("registration must open before it closes")
+	}
 	return nil
 }
 
+type RegistrationOverrideRequest struct {
+	GrantedBy string `json:"grantedBy"`
+}
+
+func (_self RegistrationOverrideRequest) validation() error {
+	if _self.GrantedBy == "" {
+		return errors.New("granted by is required")
+	}
+	return nil
+}
+
+type CoursesResponse struct {
+	Success bool                  `json:"success"`
+	Courses []*models.CourseModel `json:"courses"`
+}
+
 type CourseResponse struct {
 	Success bool               `json:"success"`
 	Course  *models.CourseModel `json:"course"`
@@ -75,6 +149,12 @@ type CourseWithTeacherRequest struct {
 	Name      string `json:"name"`
 	StartTime string `json:"startTime"`
 	EndTime   string `json:"endTime"`
+	Credits   int    `json:"credits"`
+
+	RegistrationOpensAt  *string `json:"registrationOpensAt"`
+	RegistrationClosesAt *string `json:"registrationClosesAt"`
+
+	TermID  int             `json:"termID"`
 	Teacher *TeacherRequest `json:"teacher"`
 }
 
@@ -102,6 +182,12 @@ func (_self RegisterCourseRequest) validation() error {
 		Name: _self.Course.Name,
 		StartTime: _self.Course.StartTime,
 		EndTime: _self.Course.EndTime,
+		Credits: _self.Course.Credits,
+
+		RegistrationOpensAt:  _self.Course.RegistrationOpensAt,
+		RegistrationClosesAt: _self.Course.RegistrationClosesAt,
+
+		TermID: _self.Course.TermID,
 	}
 	if err := convertedCourse.validation(); err != nil  {
 		errMessage += err.Error() + "\n"
@@ -127,3 +213,745 @@ type RegisterCourseResponse struct {
 	Student *models.StudentModel `json:"student"`
 }
 
+
+type EnrollmentResponse struct {
+	Success    bool                          `json:"success"`
+	Enrollment *repositories.EnrollmentEntity `json:"enrollment"`
+}
+
+type WithdrawCourseRequest struct {
+	Reason        string `json:"reason"`
+	EffectiveDate string `json:"effectiveDate"`
+	PerformedBy   string `json:"performedBy"`
+}
+
+func (_self WithdrawCourseRequest) validation() error {
+	if _self.Reason == "" {
+		return errors.New("withdrawal reason is required")
+	}
+	if _self.PerformedBy == "" {
+		return errors.New("performed by is required")
+	}
+	if _self.EffectiveDate != "" {
+		if _, err := time.Parse(time.RFC3339, _self.EffectiveDate); err != nil {
+			return errors.New("effective date must be an RFC3339 timestamp")
+		}
+	}
+	return nil
+}
+
+type EnrollmentStatusRequest struct {
+	Status      string `json:"status"`
+	Reason      string `json:"reason"`
+	PerformedBy string `json:"performedBy"`
+}
+
+func (_self EnrollmentStatusRequest) validation() error {
+	if _self.Status == "" {
+		return errors.New("status is required")
+	}
+	if !isEnrollmentStatus(_self.Status) {
+		return errors.New("invalid enrollment status: " + _self.Status)
+	}
+	if _self.Status == models.EnrollmentStatusWithdrawn {
+		return WithdrawCourseRequest{Reason: _self.Reason, PerformedBy: _self.PerformedBy}.validation()
+	}
+	return nil
+}
+
+func isEnrollmentStatus(status string) bool {
+	for _, enrollmentStatus := range models.EnrollmentStatuses {
+		if status == enrollmentStatus {
+			return true
+		}
+	}
+	return false
+}
+
+type StudentCourseFilterRequest struct {
+	From   string
+	To     string
+	Status string
+}
+
+func (_self StudentCourseFilterRequest) validation() error {
+	if err := (ScheduleFilterRequest{From: _self.From, To: _self.To}).validation(); err != nil {
+		return err
+	}
+	if _self.Status == "" || isEnrollmentStatus(_self.Status) {
+		return nil
+	}
+	return errors.New("invalid enrollment status: " + _self.Status)
+}
+
+type StudentCoursesResponse struct {
+	Success bool                  `json:"success"`
+	Courses []*models.CourseModel `json:"courses"`
+}
+
+const (
+	defaultRosterLimit = 20
+	maxRosterLimit     = 100
+)
+
+type RosterFilterRequest struct {
+	Limit  string
+	Offset string
+	SortBy string
+	Order  string
+}
+
+// transform validates the roster query parameters and applies their defaults
+func (_self RosterFilterRequest) transform() (*models.RosterFilterModel, error) {
+	filter := models.RosterFilterModel{
+		Limit:  defaultRosterLimit,
+		SortBy: models.RosterSortByLastName,
+		Order:  "asc",
+	}
+
+	if _self.Limit != "" {
+		limit, err := strconv.Atoi(_self.Limit)
+		if err != nil || limit <= 0 || limit > maxRosterLimit {
+			return nil, errors.New("limit must be a number between 1 and " + strconv.Itoa(maxRosterLimit))
+		}
+		filter.Limit = limit
+	}
+	if _self.Offset != "" {
+		offset, err := strconv.Atoi(_self.Offset)
+		if err != nil || offset < 0 {
+			return nil, errors.New("offset must be a non-negative number")
+		}
+		filter.Offset = offset
+	}
+	switch _self.SortBy {
+	case "":
+	case models.RosterSortByLastName, models.RosterSortByFirstName, models.RosterSortByStudentID:
+		filter.SortBy = _self.SortBy
+	default:
+		return nil, errors.New("invalid sort field: " + _self.SortBy)
+	}
+	switch _self.Order {
+	case "":
+	case "asc", "desc":
+		filter.Order = _self.Order
+	default:
+		return nil, errors.New("invalid sort order: " + _self.Order)
+	}
+	return &filter, nil
+}
+
+type CourseStudentsResponse struct {
+	Success  bool                          `json:"success"`
+	Students []*repositories.StudentEntity `json:"students"`
+	Total    int                           `json:"total"`
+	Limit    int                           `json:"limit"`
+	Offset   int                           `json:"offset"`
+}
+
+type ErrorResponse struct {
+	Success bool        `json:"success"`
+	Error   string      `json:"error"`
+	Details interface{} `json:"details"`
+}
+
+type EnrollCourseRequest struct {
+	OverrideScheduleConflict bool   `json:"overrideScheduleConflict"`
+	OverriddenBy             string `json:"overriddenBy"`
+}
+
+func (_self EnrollCourseRequest) validation() error {
+	return validateScheduleOverride(_self.OverrideScheduleConflict, _self.OverriddenBy)
+}
+
+// validateScheduleOverride requires the caller overriding the schedule conflict check to say who they are
+func validateScheduleOverride(override bool, overriddenBy string) error {
+	if override && overriddenBy == "" {
+		return errors.New("overridden by is required to override the schedule conflict check")
+	}
+	return nil
+}
+
+type PrerequisitesResponse struct {
+	Success       bool                  `json:"success"`
+	Prerequisites []*models.CourseModel `json:"prerequisites"`
+}
+
+type BulkEnrollmentRequest struct {
+	StudentIDs               []int    `json:"studentIDs"`
+	StudentCodes             []string `json:"studentCodes"`
+	Mode                     string   `json:"mode"`
+	OverrideScheduleConflict bool     `json:"overrideScheduleConflict"`
+	OverriddenBy             string   `json:"overriddenBy"`
+}
+
+func (_self BulkEnrollmentRequest) validation() error {
+	if len(_self.StudentIDs) == 0 && len(_self.StudentCodes) == 0 {
+		return errors.New("student ids or student codes are required")
+	}
+	if err := validateScheduleOverride(_self.OverrideScheduleConflict, _self.OverriddenBy); err != nil {
+		return err
+	}
+	switch _self.Mode {
+	case "", models.BulkEnrollmentAllOrNothing, models.BulkEnrollmentBestEffort:
+		return nil
+	default:
+		return errors.New("invalid bulk enrollment mode: " + _self.Mode)
+	}
+}
+
+type BulkEnrollmentResponse struct {
+	Success bool                                       `json:"success"`
+	Mode    string                                     `json:"mode"`
+	Results []*repositories.BulkEnrollmentResultEntity `json:"results"`
+}
+
+type GradeRequest struct {
+	Letter *string  `json:"letter"`
+	Score  *float64 `json:"score"`
+}
+
+func (_self GradeRequest) validation() error {
+	if (_self.Letter == nil) == (_self.Score == nil) {
+		return errors.New("either a letter or a score is required")
+	}
+	if _self.Score != nil && (*_self.Score < 0 || *_self.Score > 100) {
+		return errors.New("score must be between 0 and 100")
+	}
+	return nil
+}
+
+type TermGPAResponse struct {
+	Term    string  `json:"term"`
+	GPA     float64 `json:"gpa"`
+	Courses int     `json:"courses"`
+}
+
+type GPAResponse struct {
+	Success    bool               `json:"success"`
+	Cumulative *float64           `json:"cumulative"`
+	Courses    int                `json:"courses"`
+	Terms      []*TermGPAResponse `json:"terms"`
+}
+
+type TranscriptResponse struct {
+	Success    bool                           `json:"success"`
+	Transcript *repositories.TranscriptEntity `json:"transcript"`
+}
+
+type CreditOverrideRequest struct {
+	MaxCredits int    `json:"maxCredits"`
+	ApprovedBy string `json:"approvedBy"`
+}
+
+func (_self CreditOverrideRequest) validation() error {
+	if _self.MaxCredits <= 0 {
+		return errors.New("max credits must be greater than 0")
+	}
+	if _self.ApprovedBy == "" {
+		return errors.New("approved by is required")
+	}
+	return nil
+}
+
+type TermRequest struct {
+	Name      string `json:"name"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	Status    string `json:"status"`
+}
+
+func (_self TermRequest) validation() error {
+	if _self.Name == "" {
+		return errors.New("term name is required")
+	}
+	startDate, err := time.Parse("2006-01-02", _self.StartDate)
+	if err != nil {
+		return errors.New("start date must be a YYYY-MM-DD date")
+	}
+	endDate, err := time.Parse("2006-01-02", _self.EndDate)
+	if err != nil {
+		return errors.New("end date must be a YYYY-MM-DD date")
+	}
+	if !startDate.Before(endDate) {
+		return errors.New("term must start before it ends")
+	}
+	if _self.Status != "" && !isTermStatus(_self.Status) {
+		return errors.New("invalid term status: " + _self.Status)
+	}
+	return nil
+}
+
+func isTermStatus(status string) bool {
+	for _, termStatus := range models.TermStatuses {
+		if status == termStatus {
+			return true
+		}
+	}
+	return false
+}
+
+type TermResponse struct {
+	Success bool                     `json:"success"`
+	Term    *repositories.TermEntity `json:"term"`
+}
+
+type TermsResponse struct {
+	Success bool                       `json:"success"`
+	Terms   []*repositories.TermEntity `json:"terms"`
+}
+
+type AttendanceRecordRequest struct {
+	StudentID int    `json:"studentID"`
+	Status    string `json:"status"`
+	Note      string `json:"note"`
+}
+
+type MarkRosterRequest struct {
+	RecordedBy string                     `json:"recordedBy"`
+	Records    []*AttendanceRecordRequest `json:"records"`
+}
+
+func (_self MarkRosterRequest) validation() error {
+	if _self.RecordedBy == "" {
+		return errors.New("recorded by is required")
+	}
+	if len(_self.Records) == 0 {
+		return errors.New("attendance records are required")
+	}
+
+	students := map[int]bool{}
+	for _, record := range _self.Records {
+		if record.StudentID == 0 {
+			return errors.New("student id is required")
+		}
+		if !isAttendanceStatus(record.Status) {
+			return errors.New("invalid attendance status: " + record.Status)
+		}
+		if students[record.StudentID] {
+			return errors.New("student " + strconv.Itoa(record.StudentID) + " is listed more than once")
+		}
+		students[record.StudentID] = true
+	}
+	return nil
+}
+
+func isAttendanceStatus(status string) bool {
+	for _, attendanceStatus := range models.AttendanceStatuses {
+		if status == attendanceStatus {
+			return true
+		}
+	}
+	return false
+}
+
+type AttendanceResponse struct {
+	Success    bool                             `json:"success"`
+	Attendance []*repositories.AttendanceEntity `json:"attendance"`
+}
+
+type CourseAttendanceResponse struct {
+	Success    bool                                 `json:"success"`
+	Attendance *repositories.CourseAttendanceEntity `json:"attendance"`
+}
+
+type StudentAttendanceResponse struct {
+	Success    bool                                         `json:"success"`
+	Attendance *repositories.StudentAttendanceSummaryEntity `json:"attendance"`
+}
+
+type MeetingPatternRequest struct {
+	DaysOfWeek      []string `json:"daysOfWeek"`
+	StartTime       string   `json:"startTime"`
+	DurationMinutes int      `json:"durationMinutes"`
+	TimeZone        string   `json:"timeZone"`
+	ExcludedDates   []string `json:"excludedDates"`
+}
+
+func (_self MeetingPatternRequest) validation() error {
+	if len(_self.DaysOfWeek) == 0 {
+		return errors.New("days of week are required")
+	}
+	for _, day := range _self.DaysOfWeek {
+		if !isDayOfWeek(day) {
+			return errors.New("invalid day of week: " + day)
+		}
+	}
+	if _, err := time.Parse("15:04", _self.StartTime); err != nil {
+		return errors.New("start time must be a HH:MM time")
+	}
+	if _self.DurationMinutes <= 0 {
+		return errors.New("duration must be greater than 0 minutes")
+	}
+	if _self.TimeZone == "" {
+		return errors.New("time zone is required")
+	}
+	if _, err := time.LoadLocation(_self.TimeZone); err != nil {
+		return errors.New("unknown time zone: " + _self.TimeZone)
+	}
+	for _, date := range _self.ExcludedDates {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return errors.New("excluded date must be a YYYY-MM-DD date: " + date)
+		}
+	}
+	return nil
+}
+
+func isDayOfWeek(day string) bool {
+	for _, dayOfWeek := range models.DaysOfWeek {
+		if strings.EqualFold(day, dayOfWeek) {
+			return true
+		}
+	}
+	return false
+}
+
+type MeetingPatternResponse struct {
+	Success        bool                               `json:"success"`
+	MeetingPattern *repositories.MeetingPatternEntity `json:"meetingPattern"`
+}
+
+type SessionsResponse struct {
+	Success  bool                          `json:"success"`
+	Sessions []*repositories.SessionEntity `json:"sessions"`
+}
+
+type ScheduleFilterRequest struct {
+	From string
+	To   string
+}
+
+func (_self ScheduleFilterRequest) validation() error {
+	var from, to time.Time
+	var err error
+	if _self.From != "" {
+		if from, err = time.Parse("2006-01-02", _self.From); err != nil {
+			return errors.New("from must be a YYYY-MM-DD date")
+		}
+	}
+	if _self.To != "" {
+		if to, err = time.Parse("2006-01-02", _self.To); err != nil {
+			return errors.New("to must be a YYYY-MM-DD date")
+		}
+	}
+	if _self.From != "" && _self.To != "" && to.Before(from) {
+		return errors.New("to must not be before from")
+	}
+	return nil
+}
+
+type ScheduleEventResponse struct {
+	UID        string `json:"uid"`
+	CourseID   int    `json:"courseID"`
+	CourseName string `json:"courseName"`
+	Teacher    string `json:"teacher"`
+	StartTime  string `json:"startTime"`
+	EndTime    string `json:"endTime"`
+}
+
+type ScheduleResponse struct {
+	Success bool                     `json:"success"`
+	Events  []*ScheduleEventResponse `json:"events"`
+}
+
+type TeacherCourseFilterRequest struct {
+	TermID string
+	From   string
+	To     string
+	Status string
+}
+
+func (_self TeacherCourseFilterRequest) validation() error {
+	if err := (ScheduleFilterRequest{From: _self.From, To: _self.To}).validation(); err != nil {
+		return err
+	}
+	if _self.Status != "" && _self.Status != models.CourseStatusActive && _self.Status != models.CourseStatusPast {
+		return errors.New("status must be active or past")
+	}
+	return nil
+}
+
+type TeacherDeletionRequest struct {
+	Policy     string
+	ReassignTo string
+}
+
+func (_self TeacherDeletionRequest) validation(id string) error {
+	if _self.Policy != "" && !isTeacherDeletionPolicy(_self.Policy) {
+		return errors.New("invalid deletion policy: " + _self.Policy)
+	}
+	if _self.Policy != models.TeacherDeletionReassign {
+		if _self.ReassignTo != "" {
+			return errors.New("reassign to is only used with the reassign policy")
+		}
+		return nil
+	}
+	if _self.ReassignTo == "" {
+		return errors.New("reassign to is required")
+	}
+	if _self.ReassignTo == id {
+		return errors.New("reassign to must be another teacher")
+	}
+	return nil
+}
+
+func isTeacherDeletionPolicy(policy string) bool {
+	for _, deletionPolicy := range models.TeacherDeletionPolicies {
+		if policy == deletionPolicy {
+			return true
+		}
+	}
+	return false
+}
+
+type CourseTeacherRequest struct {
+	Role string `json:"role"`
+}
+
+func (_self CourseTeacherRequest) validation() error {
+	if _self.Role == "" {
+		return errors.New("role is required")
+	}
+	for _, role := range models.CourseTeacherRoles {
+		if _self.Role == role {
+			return nil
+		}
+	}
+	return errors.New("invalid role: " + _self.Role)
+}
+
+type CourseTeacherResponse struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Role      string `json:"role"`
+}
+
+type CourseTeachersResponse struct {
+	Success  bool                     `json:"success"`
+	Teachers []*CourseTeacherResponse `json:"teachers"`
+}
+
+// CourseV2 replaces the single teacher of a course with the list of its teachers and their roles
+type CourseV2 struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+	Capacity  *int   `json:"capacity"`
+	Credits   int    `json:"credits"`
+	Enrolled  *int   `json:"enrolled,omitempty"`
+
+	RegistrationOpensAt  *string `json:"registrationOpensAt"`
+	RegistrationClosesAt *string `json:"registrationClosesAt"`
+
+	Term         *repositories.TermEntity `json:"term"`
+	Teachers     []*CourseTeacherResponse `json:"teachers"`
+	DepartmentID *int                     `json:"departmentID"`
+}
+
+type CoursesV2Response struct {
+	Success bool        `json:"success"`
+	Courses []*CourseV2 `json:"courses"`
+}
+
+type CourseV2Response struct {
+	Success bool      `json:"success"`
+	Course  *CourseV2 `json:"course"`
+}
+
+type RoomRequest struct {
+	Building string   `json:"building"`
+	Number   string   `json:"number"`
+	Capacity int      `json:"capacity"`
+	Features []string `json:"features"`
+}
+
+func (_self RoomRequest) validation() error {
+	if _self.Building == "" {
+		return errors.New("building is required")
+	}
+	if _self.Number == "" {
+		return errors.New("room number is required")
+	}
+	if _self.Capacity <= 0 {
+		return errors.New("capacity must be greater than 0")
+	}
+	for _, feature := range _self.Features {
+		if feature == "" {
+			return errors.New("features must not be empty")
+		}
+	}
+	return nil
+}
+
+type RoomResponse struct {
+	Success bool                     `json:"success"`
+	Room    *repositories.RoomEntity `json:"room"`
+}
+
+type RoomsResponse struct {
+	Success bool                       `json:"success"`
+	Rooms   []*repositories.RoomEntity `json:"rooms"`
+}
+
+type FreeRoomsRequest struct {
+	From     string
+	To       string
+	Capacity string
+	Features []string
+}
+
+// transform validates the free rooms query parameters, from and to are required
+func (_self FreeRoomsRequest) transform() (*models.RoomFilterModel, error) {
+	from, err := time.Parse(time.RFC3339, _self.From)
+	if err != nil {
+		return nil, errors.New("from must be an RFC3339 timestamp")
+	}
+	to, err := time.Parse(time.RFC3339, _self.To)
+	if err != nil {
+		return nil, errors.New("to must be an RFC3339 timestamp")
+	}
+	if !from.Before(to) {
+		return nil, errors.New("to must be after from")
+	}
+
+	filter := models.RoomFilterModel{
+		From:     _self.From,
+		To:       _self.To,
+		Features: _self.Features,
+	}
+	if _self.Capacity != "" {
+		capacity, err := strconv.Atoi(_self.Capacity)
+		if err != nil || capacity <= 0 {
+			return nil, errors.New("capacity must be a number greater than 0")
+		}
+		filter.MinCapacity = capacity
+	}
+	return &filter, nil
+}
+
+type RoomBookingRequest struct {
+	RoomID int `json:"roomID"`
+}
+
+func (_self RoomBookingRequest) validation() error {
+	if _self.RoomID == 0 {
+		return errors.New("room id is required")
+	}
+	return nil
+}
+
+type CourseRoomsResponse struct {
+	Success bool                             `json:"success"`
+	Rooms   []*repositories.CourseRoomEntity `json:"rooms"`
+}
+
+type DepartmentRequest struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+func (_self DepartmentRequest) validation() error {
+	if _self.Code == "" {
+		return errors.New("department code is required")
+	}
+	if _self.Name == "" {
+		return errors.New("department name is required")
+	}
+	return nil
+}
+
+type DepartmentResponse struct {
+	Success    bool                           `json:"success"`
+	Department *repositories.DepartmentEntity `json:"department"`
+}
+
+type DepartmentsResponse struct {
+	Success     bool                             `json:"success"`
+	Departments []*repositories.DepartmentEntity `json:"departments"`
+}
+
+type ProgramRequest struct {
+	DepartmentID int    `json:"departmentID"`
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	Degree       string `json:"degree"`
+}
+
+func (_self ProgramRequest) validation() error {
+	if _self.DepartmentID == 0 {
+		return errors.New("department id is required")
+	}
+	if _self.Code == "" {
+		return errors.New("program code is required")
+	}
+	if _self.Name == "" {
+		return errors.New("program name is required")
+	}
+	if _self.Degree == "" {
+		return errors.New("degree is required")
+	}
+	return nil
+}
+
+type ProgramResponse struct {
+	Success bool                        `json:"success"`
+	Program *repositories.ProgramEntity `json:"program"`
+}
+
+type ProgramsResponse struct {
+	Success  bool                          `json:"success"`
+	Programs []*repositories.ProgramEntity `json:"programs"`
+}
+
+type TeachersResponse struct {
+	Success  bool                          `json:"success"`
+	Teachers []*repositories.TeacherEntity `json:"teachers"`
+}
+
+type ProgramRequirementRequest struct {
+	Name      string  `json:"name"`
+	Kind      string  `json:"kind"`
+	Credits   *int    `json:"credits"`
+	MinGrade  *string `json:"minGrade"`
+	CourseIDs []int   `json:"courseIDs"`
+}
+
+func (_self ProgramRequirementRequest) validation() error {
+	if _self.Name == "" {
+		return errors.New("requirement name is required")
+	}
+	switch _self.Kind {
+	case "":
+		return errors.New("kind is required")
+	case models.RequirementKindCredits:
+		if _self.Credits == nil || *_self.Credits <= 0 {
+			return errors.New("credits must be greater than 0")
+		}
+	case models.RequirementKindCourses:
+		if _self.Credits != nil {
+			return errors.New("credits are only used with the credits kind")
+		}
+	default:
+		return errors.New("invalid kind: " + _self.Kind)
+	}
+	if len(_self.CourseIDs) == 0 {
+		return errors.New("at least one course is required")
+	}
+	return nil
+}
+
+type ProgramRequirementResponse struct {
+	Success     bool                                   `json:"success"`
+	Requirement *repositories.ProgramRequirementEntity `json:"requirement"`
+}
+
+type ProgramRequirementsResponse struct {
+	Success      bool                                     `json:"success"`
+	Requirements []*repositories.ProgramRequirementEntity `json:"requirements"`
+}
+
+type AuditResponse struct {
+	Success bool                      `json:"success"`
+	Audit   *repositories.AuditEntity `json:"audit"`
+}
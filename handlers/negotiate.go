@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// negotiateContentType picks the offered media type the Accept header prefers, the first offer is the default.
+// It returns false when the client accepts none of the offers.
+func negotiateContentType(accept string, offers ...string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return offers[0], true
+	}
+
+	type acceptedRange struct {
+		mediaRange string
+		quality    float64
+	}
+	ranges := []acceptedRange{}
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		accepted := acceptedRange{
+			mediaRange: strings.ToLower(strings.TrimSpace(params[0])),
+			quality:    1,
+		}
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if quality, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					accepted.quality = quality
+				}
+			}
+		}
+		if accepted.quality > 0 {
+			ranges = append(ranges, accepted)
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	for _, accepted := range ranges {
+		for _, offer := range offers {
+			if mediaRangeMatches(accepted.mediaRange, offer) {
+				return offer, true
+			}
+		}
+	}
+	return "", false
+}
+
+func mediaRangeMatches(mediaRange string, mediaType string) bool {
+	if mediaRange == "*/*" || mediaRange == mediaType {
+		return true
+	}
+	if strings.HasSuffix(mediaRange, "/*") {
+		return strings.HasPrefix(mediaType, strings.TrimSuffix(mediaRange, "*"))
+	}
+	return false
+}
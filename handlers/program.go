@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"student_rest/models"
+
+	"github.com/go-chi/chi"
+	"student_rest/services"
+)
+
+type ProgramHandlers struct {
+	services.ProgramServices
+}
+
+func (_self ProgramHandlers) CreateProgram(w http.ResponseWriter, r *http.Request) {
+	var program ProgramRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&program); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := program.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedProgram := transformProgramRequestToProgramModel(program)
+	result, err := _self.ProgramServices.CreateProgram(&convertedProgram)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(ProgramResponse{
+		Success: true,
+		Program: result,
+	})
+}
+
+func transformProgramRequestToProgramModel(request ProgramRequest) models.ProgramModel {
+	return models.ProgramModel{
+		DepartmentID: request.DepartmentID,
+		Code:         request.Code,
+		Name:         request.Name,
+		Degree:       request.Degree,
+	}
+}
+
+// GetPrograms lists the programs, the departmentId query parameter keeps the programs of one department
+func (_self ProgramHandlers) GetPrograms(w http.ResponseWriter, r *http.Request) {
+	result, err := _self.ProgramServices.GetPrograms(&models.ProgramFilterModel{
+		DepartmentID: r.URL.Query().Get("departmentId"),
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(ProgramsResponse{
+		Success:  true,
+		Programs: result,
+	})
+}
+
+func (_self ProgramHandlers) GetProgramByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.ProgramServices.GetProgramByID(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(ProgramResponse{
+		Success: true,
+		Program: result,
+	})
+}
+
+func (_self ProgramHandlers) UpdateProgram(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var program ProgramRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&program); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := program.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedProgram := transformProgramRequestToProgramModel(program)
+	if err := _self.ProgramServices.UpdateProgram(id, &convertedProgram); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self ProgramHandlers) DeleteProgram(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	if err := _self.ProgramServices.DeleteProgram(id); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self ProgramHandlers) GetProgramRequirements(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.ProgramServices.GetProgramRequirements(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(ProgramRequirementsResponse{
+		Success:      true,
+		Requirements: result,
+	})
+}
+
+func (_self ProgramHandlers) CreateProgramRequirement(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var requirement ProgramRequirementRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&requirement); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := requirement.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedRequirement := transformProgramRequirementRequestToProgramRequirementModel(requirement)
+	result, err := _self.ProgramServices.CreateProgramRequirement(id, &convertedRequirement)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(ProgramRequirementResponse{
+		Success:     true,
+		Requirement: result,
+	})
+}
+
+func transformProgramRequirementRequestToProgramRequirementModel(request ProgramRequirementRequest) models.ProgramRequirementModel {
+	return models.ProgramRequirementModel{
+		Name:      request.Name,
+		Kind:      request.Kind,
+		Credits:   request.Credits,
+		MinGrade:  request.MinGrade,
+		CourseIDs: request.CourseIDs,
+	}
+}
+
+func (_self ProgramHandlers) UpdateProgramRequirement(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	requirementID := chi.URLParam(r, "requirementId")
+
+	var requirement ProgramRequirementRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&requirement); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := requirement.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedRequirement := transformProgramRequirementRequestToProgramRequirementModel(requirement)
+	if err := _self.ProgramServices.UpdateProgramRequirement(id, requirementID, &convertedRequirement); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self ProgramHandlers) DeleteProgramRequirement(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	requirementID := chi.URLParam(r, "requirementId")
+
+	if err := _self.ProgramServices.DeleteProgramRequirement(id, requirementID); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
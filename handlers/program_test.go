@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockProgramService struct {
+	mock.Mock
+}
+
+func (m *MockProgramService) CreateProgram(program *models.ProgramModel) (*repositories.ProgramEntity, error) {
+	returnArgs := m.Called(program)
+	return returnArgs.Get(0).(*repositories.ProgramEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramService) GetPrograms(filter *models.ProgramFilterModel) ([]*repositories.ProgramEntity, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*repositories.ProgramEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramService) GetProgramByID(id string) (*repositories.ProgramEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.ProgramEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramService) UpdateProgram(id string, program *models.ProgramModel) error {
+	returnArgs := m.Called(id, program)
+	return returnArgs.Error(0)
+}
+
+func (m *MockProgramService) DeleteProgram(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+func (m *MockProgramService) GetProgramRequirements(id string) ([]*repositories.ProgramRequirementEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*repositories.ProgramRequirementEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramService) CreateProgramRequirement(id string, requirement *models.ProgramRequirementModel) (*repositories.ProgramRequirementEntity, error) {
+	returnArgs := m.Called(id, requirement)
+	return returnArgs.Get(0).(*repositories.ProgramRequirementEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramService) UpdateProgramRequirement(id string, requirementID string, requirement *models.ProgramRequirementModel) error {
+	returnArgs := m.Called(id, requirementID, requirement)
+	return returnArgs.Error(0)
+}
+
+func (m *MockProgramService) DeleteProgramRequirement(id string, requirementID string) error {
+	returnArgs := m.Called(id, requirementID)
+	return returnArgs.Error(0)
+}
+
+func Test_CreateProgramRequirement(t *testing.T) {
+	var noRequirement *repositories.ProgramRequirementEntity
+	credits := 6
+	minGrade := "C"
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.ProgramRequirementModel
+		mockServiceResult    *repositories.ProgramRequirementEntity
+		mockServiceError     error
+	}{
+		{
+			name:    "invalid kind",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"name":      "Core",
+				"kind":      "grades",
+				"courseIDs": []int{1},
+			},
+			expectedResponseBody: "invalid kind: grades\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "credits requirement without credits",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"name":      "Electives",
+				"kind":      "credits",
+				"courseIDs": []int{3, 4},
+			},
+			expectedResponseBody: "credits must be greater than 0\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "requirement without courses",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"name": "Core",
+				"kind": "courses",
+			},
+			expectedResponseBody: "at least one course is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "minimum grade not on the grade scale",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"name":      "Core",
+				"kind":      "courses",
+				"minGrade":  "C",
+				"courseIDs": []int{1, 2},
+			},
+			expectedResponseBody: "grade is not on the grade scale\n",
+			expectedStatus:       http.StatusBadRequest,
+			mockServiceInput:     &models.ProgramRequirementModel{Name: "Core", Kind: "courses", MinGrade: &minGrade, CourseIDs: []int{1, 2}},
+			mockServiceResult:    noRequirement,
+			mockServiceError:     models.ErrInvalidGrade,
+		},
+		{
+			name:    "create requirement successfully",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"name":      "Electives",
+				"kind":      "credits",
+				"credits":   6,
+				"courseIDs": []int{3, 4},
+			},
+			expectedResponseBody: "{\"success\":true,\"requirement\":{\"id\":2,\"programID\":1,\"name\":\"Electives\",\"kind\":\"credits\",\"credits\":6,\"minGrade\":null,\"courses\":[{\"id\":3,\"name\":\"Art\",\"credits\":3},{\"id\":4,\"name\":\"Music\",\"credits\":3}]}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.ProgramRequirementModel{Name: "Electives", Kind: "credits", Credits: &credits, CourseIDs: []int{3, 4}},
+			mockServiceResult: &repositories.ProgramRequirementEntity{ID: 2, ProgramID: 1, Name: "Electives", Kind: "credits", Credits: &credits,
+				Courses: []*repositories.RequirementCourseEntity{{ID: 3, Name: "Art", Credits: 3}, {ID: 4, Name: "Music", Credits: 3}}},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockProgramService)
+			mockService.On("CreateProgramRequirement", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			programHandler := ProgramHandlers{
+				ProgramServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPost, "/programs/program/{id}/requirements", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(programHandler.CreateProgramRequirement)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"student_rest/models"
+
+	"github.com/go-chi/chi"
+	"student_rest/services"
+)
+
+type RoomHandlers struct {
+	services.RoomServices
+}
+
+func (_self RoomHandlers) CreateRoom(w http.ResponseWriter, r *http.Request) {
+	var room RoomRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&room); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := room.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedRoom := transformRoomRequestToRoomModel(room)
+	result, err := _self.RoomServices.CreateRoom(&convertedRoom)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(RoomResponse{
+		Success: true,
+		Room:    result,
+	})
+}
+
+func transformRoomRequestToRoomModel(request RoomRequest) models.RoomModel {
+	return models.RoomModel{
+		Building: request.Building,
+		Number:   request.Number,
+		Capacity: request.Capacity,
+		Features: request.Features,
+	}
+}
+
+func (_self RoomHandlers) GetRooms(w http.ResponseWriter, r *http.Request) {
+	result, err := _self.RoomServices.GetRooms()
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(RoomsResponse{
+		Success: true,
+		Rooms:   result,
+	})
+}
+
+// GetFreeRooms lists the rooms nothing takes between the from and to query parameters,
+// capacity and feature narrow them down to the rooms with enough seats and every listed feature
+func (_self RoomHandlers) GetFreeRooms(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filterRequest := FreeRoomsRequest{
+		From:     query.Get("from"),
+		To:       query.Get("to"),
+		Capacity: query.Get("capacity"),
+		Features: query["feature"],
+	}
+
+	filter, err := filterRequest.transform()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.RoomServices.GetFreeRooms(filter)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(RoomsResponse{
+		Success: true,
+		Rooms:   result,
+	})
+}
+
+func (_self RoomHandlers) GetRoomByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.RoomServices.GetRoomByID(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(RoomResponse{
+		Success: true,
+		Room:    result,
+	})
+}
+
+func (_self RoomHandlers) UpdateRoom(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var room RoomRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&room); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := room.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedRoom := transformRoomRequestToRoomModel(room)
+	if err := _self.RoomServices.UpdateRoom(id, &convertedRoom); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self RoomHandlers) DeleteRoom(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	if err := _self.RoomServices.DeleteRoom(id); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
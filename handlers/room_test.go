@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockRoomService struct {
+	mock.Mock
+}
+
+func (m *MockRoomService) CreateRoom(room *models.RoomModel) (*repositories.RoomEntity, error) {
+	returnArgs := m.Called(room)
+	return returnArgs.Get(0).(*repositories.RoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomService) GetRooms() ([]*repositories.RoomEntity, error) {
+	returnArgs := m.Called()
+	return returnArgs.Get(0).([]*repositories.RoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomService) GetRoomByID(id string) (*repositories.RoomEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.RoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomService) UpdateRoom(id string, room *models.RoomModel) error {
+	returnArgs := m.Called(id, room)
+	return returnArgs.Error(0)
+}
+
+func (m *MockRoomService) DeleteRoom(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+func (m *MockRoomService) GetFreeRooms(filter *models.RoomFilterModel) ([]*repositories.RoomEntity, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*repositories.RoomEntity), returnArgs.Error(1)
+}
+
+func Test_CreateRoom(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.RoomModel
+		mockServiceResult    *repositories.RoomEntity
+		mockServiceError     error
+	}{
+		{
+			name: "validate request body fail",
+			requestBody: map[string]interface{}{
+				"building": "A",
+				"number":   "101",
+			},
+			expectedResponseBody: "capacity must be greater than 0\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name: "room already exists",
+			requestBody: map[string]interface{}{
+				"building": "A",
+				"number":   "101",
+				"capacity": 30,
+			},
+			expectedResponseBody: "building already has a room with this number\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     &models.RoomModel{Building: "A", Number: "101", Capacity: 30},
+			mockServiceError:     models.ErrRoomExists,
+		},
+		{
+			name: "create room successfully",
+			requestBody: map[string]interface{}{
+				"building": "A",
+				"number":   "101",
+				"capacity": 30,
+				"features": []string{"projector"},
+			},
+			expectedResponseBody: "{\"success\":true,\"room\":{\"id\":1,\"building\":\"A\",\"number\":\"101\",\"capacity\":30,\"features\":[\"projector\"]}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.RoomModel{Building: "A", Number: "101", Capacity: 30, Features: []string{"projector"}},
+			mockServiceResult:    &repositories.RoomEntity{ID: 1, Building: "A", Number: "101", Capacity: 30, Features: []string{"projector"}},
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockRoomService)
+			mockService.On("CreateRoom", testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			roomHandler := RoomHandlers{
+				RoomServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPost, "/rooms", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(roomHandler.CreateRoom)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetFreeRooms(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		query                string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.RoomFilterModel
+		mockServiceResult    []*repositories.RoomEntity
+	}{
+		{
+			name:                 "from is required",
+			query:                "?to=2020-11-02T10:00:00Z",
+			expectedResponseBody: "from must be an RFC3339 timestamp\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "to before from",
+			query:                "?from=2020-11-02T10:00:00Z&to=2020-11-02T09:00:00Z",
+			expectedResponseBody: "to must be after from\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "invalid capacity",
+			query:                "?from=2020-11-02T09:00:00Z&to=2020-11-02T10:00:00Z&capacity=many",
+			expectedResponseBody: "capacity must be a number greater than 0\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "get free rooms successfully",
+			query:                "?from=2020-11-02T09:00:00Z&to=2020-11-02T10:00:00Z&capacity=20&feature=projector&feature=lab",
+			expectedResponseBody: "{\"success\":true,\"rooms\":[{\"id\":2,\"building\":\"B\",\"number\":\"201\",\"capacity\":40,\"features\":[\"lab\",\"projector\"]}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.RoomFilterModel{
+				From:        "2020-11-02T09:00:00Z",
+				To:          "2020-11-02T10:00:00Z",
+				MinCapacity: 20,
+				Features:    []string{"projector", "lab"},
+			},
+			mockServiceResult: []*repositories.RoomEntity{
+				{ID: 2, Building: "B", Number: "201", Capacity: 40, Features: []string{"lab", "projector"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockRoomService)
+			mockService.On("GetFreeRooms", testCase.mockServiceInput).Return(testCase.mockServiceResult, nil)
+
+			roomHandler := RoomHandlers{
+				RoomServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/rooms/free"+testCase.query, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(roomHandler.GetFreeRooms)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"encoding/json"
 	"github.com/go-chi/chi"
+	"io"
 	"net/http"
 	"student_rest/models"
+	"time"
 
 	"student_rest/services"
 )
@@ -30,7 +32,7 @@ func (_self StudentHandlers) CreateStudent(w http.ResponseWriter, r *http.Reques
 	result, err := _self.StudentServices.CreateStudent(convertedStudent)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -46,6 +48,8 @@ func transformStudentRequestToStudentModel(request StudentRequest) *models.Stude
 		FirstName:   request.FirstName,
 		LastName:    request.LastName,
 		DateOfBirth: request.DateOfBirth,
+
+		ProgramID: request.ProgramID,
 	}
 }
 
@@ -97,7 +101,7 @@ func (_self StudentHandlers) UpdateStudent(w http.ResponseWriter, r *http.Reques
 	err := _self.StudentServices.UpdateStudent(id, convertedStudent)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -125,6 +129,14 @@ func (_self StudentHandlers) RegisterCourse(w http.ResponseWriter, r *http.Reque
 			Name:      registerCourseRequest.Course.Name,
 			StartTime: registerCourseRequest.Course.StartTime,
 			EndTime:   registerCourseRequest.Course.EndTime,
+			Credits:   registerCourseRequest.Course.Credits,
+
+			RegistrationOpensAt:  registerCourseRequest.Course.RegistrationOpensAt,
+			RegistrationClosesAt: registerCourseRequest.Course.RegistrationClosesAt,
+
+			Term: &models.TermModel{
+				ID: registerCourseRequest.Course.TermID,
+			},
 			Teacher: &models.TeacherModel{
 				FirstName:   registerCourseRequest.Course.Teacher.FirstName,
 				LastName:    registerCourseRequest.Course.Teacher.LastName,
@@ -135,7 +147,7 @@ func (_self StudentHandlers) RegisterCourse(w http.ResponseWriter, r *http.Reque
 	result, err := _self.StudentServices.RegisterCourse(&registerCourseModel)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -146,3 +158,282 @@ func (_self StudentHandlers) RegisterCourse(w http.ResponseWriter, r *http.Reque
 	})
 	return
 }
+
+func (_self StudentHandlers) EnrollCourse(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	courseID := chi.URLParam(r, "courseId")
+
+	// The body is optional, it is only needed to override enrollment checks
+	var enrollCourse EnrollCourseRequest
+	if r.Body != nil {
+		if err := json.NewDecoder(r.Body).Decode(&enrollCourse); err != nil && err != io.EOF {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if err := enrollCourse.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.StudentServices.EnrollCourse(id, courseID, &models.EnrollmentOptionsModel{
+		OverrideScheduleConflict: enrollCourse.OverrideScheduleConflict,
+		OverriddenBy:             enrollCourse.OverriddenBy,
+	})
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// The course is full and the student has been put on its waitlist
+	if result.Status == models.EnrollmentStatusWaitlisted {
+		w.WriteHeader(http.StatusAccepted)
+	}
+
+	json.NewEncoder(w).Encode(EnrollmentResponse{
+		Success:    true,
+		Enrollment: result,
+	})
+}
+
+func (_self StudentHandlers) WithdrawCourse(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	courseID := chi.URLParam(r, "courseId")
+
+	var withdrawal WithdrawCourseRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&withdrawal); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := withdrawal.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.StudentServices.WithdrawCourse(id, courseID, &models.WithdrawCourseModel{
+		Reason:        withdrawal.Reason,
+		EffectiveDate: withdrawal.EffectiveDate,
+		PerformedBy:   withdrawal.PerformedBy,
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(EnrollmentResponse{
+		Success:    true,
+		Enrollment: result,
+	})
+}
+
+func (_self StudentHandlers) GetStudentCourses(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	query := r.URL.Query()
+	filter := StudentCourseFilterRequest{
+		From:   query.Get("from"),
+		To:     query.Get("to"),
+		Status: query.Get("status"),
+	}
+
+	if err := filter.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.StudentServices.GetStudentCourses(id, &models.StudentCourseFilterModel{
+		From:   filter.From,
+		To:     filter.To,
+		Status: filter.Status,
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(StudentCoursesResponse{
+		Success: true,
+		Courses: result,
+	})
+}
+
+func (_self StudentHandlers) RecordGrade(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	courseID := chi.URLParam(r, "courseId")
+
+	var grade GradeRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&grade); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := grade.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.StudentServices.RecordGrade(id, courseID, &models.RecordGradeModel{
+		Letter: grade.Letter,
+		Score:  grade.Score,
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(EnrollmentResponse{
+		Success:    true,
+		Enrollment: result,
+	})
+}
+
+func (_self StudentHandlers) GetStudentGPA(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.StudentServices.GetStudentGPA(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	terms := make([]*TermGPAResponse, 0, len(result.Terms))
+	for _, term := range result.Terms {
+		terms = append(terms, &TermGPAResponse{
+			Term:    term.Term,
+			GPA:     term.GPA,
+			Courses: term.Courses,
+		})
+	}
+
+	json.NewEncoder(w).Encode(GPAResponse{
+		Success:    true,
+		Cumulative: result.Cumulative,
+		Courses:    result.Courses,
+		Terms:      terms,
+	})
+}
+
+// GetStudentTranscript renders the transcript as JSON, CSV or a printable HTML page depending on the Accept header
+func (_self StudentHandlers) GetStudentTranscript(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	contentType, ok := negotiateContentType(r.Header.Get("Accept"), mediaTypeJSON, mediaTypeCSV, mediaTypeHTML)
+	if !ok {
+		http.Error(w, "transcript is available as application/json, text/csv or text/html", http.StatusNotAcceptable)
+		return
+	}
+
+	result, err := _self.StudentServices.GetStudentTranscript(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.Header().Set("Vary", "Accept")
+	switch contentType {
+	case mediaTypeCSV:
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="transcript-`+result.Student.StudentID+`.csv"`)
+		err = writeTranscriptCSV(w, result)
+	case mediaTypeHTML:
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		err = transcriptTemplate.Execute(w, result)
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(TranscriptResponse{
+			Success:    true,
+			Transcript: result,
+		})
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// SetCreditOverride records an approved overload, raising the credit limit of the student per term
+func (_self StudentHandlers) SetCreditOverride(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var override CreditOverrideRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&override); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := override.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err := _self.StudentServices.SetCreditOverride(id, &models.CreditOverrideModel{
+		MaxCredits: override.MaxCredits,
+		ApprovedBy: override.ApprovedBy,
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self StudentHandlers) DeleteCreditOverride(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	if err := _self.StudentServices.DeleteCreditOverride(id); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+// GetStudentSchedule serves the courses the student is enrolled in as an iCalendar feed, one event per session
+func (_self StudentHandlers) GetStudentSchedule(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	result, err := _self.StudentServices.GetStudentSchedule(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", mediaTypeCalendar+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="schedule-`+id+`.ics"`)
+	if err = writeCalendar(w, "Student "+id+" schedule", result, time.Now()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// GetStudentAudit reports which requirements of the student's program are satisfied, in progress or missing
+func (_self StudentHandlers) GetStudentAudit(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.StudentServices.GetStudentAudit(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(AuditResponse{
+		Success: true,
+		Audit:   result,
+	})
+}
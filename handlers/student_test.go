@@ -8,8 +8,10 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"student_rest/models"
 	"student_rest/repositories"
 	"testing"
@@ -45,6 +47,56 @@ func (m *MockStudentService) RegisterCourse(registerCourseModel *models.Register
 
 }
 
+func (m *MockStudentService) EnrollCourse(studentID string, courseID string, options *models.EnrollmentOptionsModel) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(studentID, courseID, options)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentService) WithdrawCourse(studentID string, courseID string, withdrawal *models.WithdrawCourseModel) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(studentID, courseID, withdrawal)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentService) RecordGrade(studentID string, courseID string, grade *models.RecordGradeModel) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(studentID, courseID, grade)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentService) GetStudentGPA(studentID string) (*models.GPAModel, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).(*models.GPAModel), returnArgs.Error(1)
+}
+
+func (m *MockStudentService) GetStudentTranscript(studentID string) (*repositories.TranscriptEntity, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).(*repositories.TranscriptEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentService) SetCreditOverride(studentID string, override *models.CreditOverrideModel) error {
+	returnArgs := m.Called(studentID, override)
+	return returnArgs.Error(0)
+}
+
+func (m *MockStudentService) DeleteCreditOverride(studentID string) error {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Error(0)
+}
+
+func (m *MockStudentService) GetStudentSchedule(studentID string) ([]*models.ScheduleEventModel, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).([]*models.ScheduleEventModel), returnArgs.Error(1)
+}
+
+func (m *MockStudentService) GetStudentAudit(studentID string) (*repositories.AuditEntity, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).(*repositories.AuditEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentService) GetStudentCourses(studentID string, filter *models.StudentCourseFilterModel) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(studentID, filter)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
 func Test_CreateStudent(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -408,7 +460,8 @@ func Test_RegisterCourse(t *testing.T) {
 				"course": map[string]interface{} {
 					"name": "Math",
 					"startTime":"1998-11-02T00:00:00Z",
-					"endTime": "1998-11-02T00:00:00Z",
+					"endTime": "1998-11-03T00:00:00Z",
+					"termID": 1,
 					"teacher": map[string]interface{}{
 						"firstName": "Dao",
 						"lastName": "Mai",
@@ -427,7 +480,10 @@ func Test_RegisterCourse(t *testing.T) {
 				Course: &models.CourseModel{
 					Name:      "Math",
 					StartTime: "1998-11-02T00:00:00Z",
-					EndTime:   "1998-11-02T00:00:00Z",
+					EndTime:   "1998-11-03T00:00:00Z",
+					Term: &models.TermModel{
+						ID: 1,
+					},
 					Teacher: &models.TeacherModel{
 						FirstName:   "Dao",
 						LastName:    "Mai",
@@ -449,7 +505,8 @@ func Test_RegisterCourse(t *testing.T) {
 				"course": map[string]interface{} {
 					"name": "Math",
 					"startTime":"1998-11-02T00:00:00Z",
-					"endTime": "1998-11-02T00:00:00Z",
+					"endTime": "1998-11-03T00:00:00Z",
+					"termID": 1,
 					"teacher": map[string]interface{}{
 						"firstName": "Dao",
 						"lastName": "Mai",
@@ -457,7 +514,7 @@ func Test_RegisterCourse(t *testing.T) {
 					},
 				},
 			},
-			expectedResponseBody: "{\"success\":true,\"course\":{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"1998-11-02T00:00:00Z\",\"EndTime\":\"1998-11-02T00:00:00Z\",\"Teacher\":{\"ID\":1,\"FirstName\":\"Dao\",\"LastName\":\"Mai\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}},\"student\":{\"ID\":1,\"StudentID\":\"123456\",\"FirstName\":\"Dao\",\"LastName\":\"Mai\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}\n",
+			expectedResponseBody: "{\"success\":true,\"course\":{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"1998-11-02T00:00:00Z\",\"EndTime\":\"1998-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":{\"ID\":1,\"FirstName\":\"Dao\",\"LastName\":\"Mai\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}},\"student\":{\"ID\":1,\"StudentID\":\"123456\",\"FirstName\":\"Dao\",\"LastName\":\"Mai\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}\n",
 			expectedStatus:       http.StatusOK,
 			mockServiceInput: &models.RegisterCourseModel{
 				Student: &models.StudentModel{
@@ -468,7 +525,10 @@ func Test_RegisterCourse(t *testing.T) {
 				Course: &models.CourseModel{
 					Name:      "Math",
 					StartTime: "1998-11-02T00:00:00Z",
-					EndTime:   "1998-11-02T00:00:00Z",
+					EndTime:   "1998-11-03T00:00:00Z",
+					Term: &models.TermModel{
+						ID: 1,
+					},
 					Teacher: &models.TeacherModel{
 						FirstName:   "Dao",
 						LastName:    "Mai",
@@ -488,7 +548,7 @@ func Test_RegisterCourse(t *testing.T) {
 					ID: 1,
 					Name:      "Math",
 					StartTime: "1998-11-02T00:00:00Z",
-					EndTime:   "1998-11-02T00:00:00Z",
+					EndTime:   "1998-11-03T00:00:00Z",
 					Teacher: &models.TeacherModel{
 						ID: 1,
 						FirstName:   "Dao",
@@ -531,3 +591,1100 @@ func Test_RegisterCourse(t *testing.T) {
 	}
 }
 
+
+func Test_EnrollCourse(t *testing.T) {
+	waitlistPosition := 1
+	closedAt := "2020-11-01T00:00:00Z"
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramCourseID        string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.EnrollmentOptionsModel
+		mockServiceResult    *repositories.EnrollmentEntity
+		mockServiceError     error
+	}{
+		{
+			name:          "decode request body fail",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"overrideScheduleConflict": "yes",
+			},
+			expectedResponseBody: "json: cannot unmarshal string into Go struct field EnrollCourseRequest.overrideScheduleConflict of type bool\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "student not found",
+			paramID:              "3",
+			paramCourseID:        "1",
+			expectedResponseBody: "student not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:                 "course not found",
+			paramID:              "1",
+			paramCourseID:        "3",
+			expectedResponseBody: "course not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrCourseNotFound,
+		},
+		{
+			name:                 "student already enrolled",
+			paramID:              "1",
+			paramCourseID:        "1",
+			expectedResponseBody: "student is already enrolled in this course\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrAlreadyEnrolled,
+		},
+		{
+			name:                 "registration closed",
+			paramID:              "1",
+			paramCourseID:        "2",
+			expectedResponseBody: "{\"success\":false,\"error\":\"registration for this course closed at 2020-11-01T00:00:00Z\",\"details\":{\"closesAt\":\"2020-11-01T00:00:00Z\",\"code\":\"REGISTRATION_CLOSED\",\"opensAt\":null}}\n",
+			expectedStatus:       http.StatusForbidden,
+			mockServiceResult:    nil,
+			mockServiceError: &models.RegistrationWindowError{
+				Code:     models.RegistrationClosed,
+				ClosesAt: &closedAt,
+			},
+		},
+		{
+			name:                 "credit limit exceeded",
+			paramID:              "1",
+			paramCourseID:        "2",
+			expectedResponseBody: "{\"success\":false,\"error\":\"enrolling would exceed the credit limit for Fall 2020: current load 16, course credits 4, limit 18\",\"details\":{\"courseCredits\":4,\"currentCredits\":16,\"limit\":18,\"term\":\"Fall 2020\"}}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceResult:    nil,
+			mockServiceError: &models.CreditLimitExceededError{
+				Term:           "Fall 2020",
+				CurrentCredits: 16,
+				CourseCredits:  4,
+				Limit:          18,
+			},
+		},
+		{
+			name:                 "missing prerequisites",
+			paramID:              "1",
+			paramCourseID:        "2",
+			expectedResponseBody: "{\"success\":false,\"error\":\"missing prerequisites: Math\",\"details\":[{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":null}]}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceResult:    nil,
+			mockServiceError: &models.MissingPrerequisitesError{
+				Courses: []*models.CourseModel{
+					{
+						ID:        1,
+						Name:      "Math",
+						StartTime: "2020-11-02T00:00:00Z",
+						EndTime:   "2020-11-03T00:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			name:                 "schedule conflict",
+			paramID:              "1",
+			paramCourseID:        "2",
+			expectedResponseBody: "{\"success\":false,\"error\":\"schedule conflicts with enrolled courses: Math\",\"details\":[{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":null}]}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceResult:    nil,
+			mockServiceError: &models.ScheduleConflictError{
+				Courses: []*models.CourseModel{
+					{
+						ID:        1,
+						Name:      "Math",
+						StartTime: "2020-11-02T00:00:00Z",
+						EndTime:   "2020-11-03T00:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			name:          "schedule conflict overridden without overridden by",
+			paramID:       "1",
+			paramCourseID: "2",
+			requestBody: map[string]interface{}{
+				"overrideScheduleConflict": true,
+			},
+			expectedResponseBody: "overridden by is required to override the schedule conflict check\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:          "schedule conflict overridden",
+			paramID:       "1",
+			paramCourseID: "2",
+			requestBody: map[string]interface{}{
+				"overrideScheduleConflict": true,
+				"overriddenBy":             "registrar",
+			},
+			expectedResponseBody: "{\"success\":true,\"enrollment\":{\"id\":2,\"studentID\":1,\"courseID\":2,\"status\":\"active\"}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.EnrollmentOptionsModel{
+				OverrideScheduleConflict: true,
+				OverriddenBy:             "registrar",
+			},
+			mockServiceResult: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  2,
+				Status:    "active",
+			},
+			mockServiceError: nil,
+		},
+		{
+			name:                 "course full and student waitlisted",
+			paramID:              "2",
+			paramCourseID:        "2",
+			expectedResponseBody: "{\"success\":true,\"enrollment\":{\"id\":3,\"studentID\":2,\"courseID\":2,\"status\":\"waitlisted\",\"waitlistPosition\":1}}\n",
+			expectedStatus:       http.StatusAccepted,
+			mockServiceResult: &repositories.EnrollmentEntity{
+				ID:               3,
+				StudentID:        2,
+				CourseID:         2,
+				Status:           "waitlisted",
+				WaitlistPosition: &waitlistPosition,
+			},
+			mockServiceError: nil,
+		},
+		{
+			name:                 "enroll course fail",
+			paramID:              "2",
+			paramCourseID:        "1",
+			expectedResponseBody: "enroll course fail\n",
+			expectedStatus:       http.StatusInternalServerError,
+			mockServiceResult:    nil,
+			mockServiceError:     errors.New("enroll course fail"),
+		},
+		{
+			name:                 "enroll course successfully",
+			paramID:              "2",
+			paramCourseID:        "1",
+			expectedResponseBody: "{\"success\":true,\"enrollment\":{\"id\":2,\"studentID\":2,\"courseID\":1,\"status\":\"active\"}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 2,
+				CourseID:  1,
+				Status:    "active",
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			options := testCase.mockServiceInput
+			if options == nil {
+				options = &models.EnrollmentOptionsModel{}
+			}
+			mockService := new(MockStudentService)
+			mockService.On("EnrollCourse", testCase.paramID, testCase.paramCourseID, options).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			var body io.Reader
+			if testCase.requestBody != nil {
+				requestBody, err := json.Marshal(testCase.requestBody)
+				if err != nil {
+					t.Error(err)
+				}
+				body = bytes.NewBuffer(requestBody)
+			}
+			req, err := http.NewRequest(http.MethodPost, "/students/student/{id}/courses/{courseId}", body)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("courseId", testCase.paramCourseID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.EnrollCourse)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_WithdrawCourse(t *testing.T) {
+	reason := "Schedule change"
+	withdrawnAt := "2020-11-05T00:00:00Z"
+	withdrawnBy := "registrar"
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramCourseID        string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.WithdrawCourseModel
+		mockServiceResult    *repositories.EnrollmentEntity
+		mockServiceError     error
+	}{
+		{
+			name:          "decode request body fail",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"reason": 1,
+			},
+			expectedResponseBody: "json: cannot unmarshal number into Go struct field WithdrawCourseRequest.reason of type string\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:          "validate request body fail",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"reason": "Schedule change",
+			},
+			expectedResponseBody: "performed by is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:          "invalid effective date",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"reason":        "Schedule change",
+				"effectiveDate": "next monday",
+				"performedBy":   "registrar",
+			},
+			expectedResponseBody: "effective date must be an RFC3339 timestamp\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:          "student not enrolled",
+			paramID:       "2",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"reason":        "Schedule change",
+				"effectiveDate": "2020-11-05T00:00:00Z",
+				"performedBy":   "registrar",
+			},
+			expectedResponseBody: "student is not enrolled in this course\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput: &models.WithdrawCourseModel{
+				Reason:        "Schedule change",
+				EffectiveDate: "2020-11-05T00:00:00Z",
+				PerformedBy:   "registrar",
+			},
+			mockServiceResult: nil,
+			mockServiceError:  models.ErrNotEnrolled,
+		},
+		{
+			name:          "withdraw course successfully",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"reason":        "Schedule change",
+				"effectiveDate": "2020-11-05T00:00:00Z",
+				"performedBy":   "registrar",
+			},
+			expectedResponseBody: "{\"success\":true,\"enrollment\":{\"id\":1,\"studentID\":1,\"courseID\":1,\"status\":\"withdrawn\",\"withdrawalReason\":\"Schedule change\",\"withdrawnAt\":\"2020-11-05T00:00:00Z\",\"withdrawnBy\":\"registrar\"}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.WithdrawCourseModel{
+				Reason:        "Schedule change",
+				EffectiveDate: "2020-11-05T00:00:00Z",
+				PerformedBy:   "registrar",
+			},
+			mockServiceResult: &repositories.EnrollmentEntity{
+				ID:               1,
+				StudentID:        1,
+				CourseID:         1,
+				Status:           "withdrawn",
+				WithdrawalReason: &reason,
+				WithdrawnAt:      &withdrawnAt,
+				WithdrawnBy:      &withdrawnBy,
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("WithdrawCourse", testCase.paramID, testCase.paramCourseID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPost, "/students/student/{id}/courses/{courseId}/withdraw", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("courseId", testCase.paramCourseID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.WithdrawCourse)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetStudentCourses(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		query                string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.StudentCourseFilterModel
+		mockServiceResult    []*models.CourseModel
+		mockServiceError     error
+	}{
+		{
+			name:                 "validate filter fail",
+			paramID:              "1",
+			query:                "?status=unknown",
+			expectedResponseBody: "invalid enrollment status: unknown\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "invalid from date",
+			paramID:              "1",
+			query:                "?from=11/1/2020",
+			expectedResponseBody: "from must be a YYYY-MM-DD date\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "to before from",
+			paramID:              "1",
+			query:                "?from=2020-11-30&to=2020-11-01",
+			expectedResponseBody: "to must not be before from\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "student not found",
+			paramID:              "3",
+			query:                "",
+			expectedResponseBody: "student not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     &models.StudentCourseFilterModel{},
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:                 "get student courses successfully",
+			paramID:              "1",
+			query:                "?from=2020-11-01&to=2020-11-30&status=active",
+			expectedResponseBody: "{\"success\":true,\"courses\":[{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":{\"ID\":1,\"FirstName\":\"Anh\",\"LastName\":\"Le\",\"DateOfBirth\":\"1998-11-02T00:00:00Z\"}}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.StudentCourseFilterModel{
+				From:   "2020-11-01",
+				To:     "2020-11-30",
+				Status: "active",
+			},
+			mockServiceResult: []*models.CourseModel{
+				{
+					ID:        1,
+					Name:      "Math",
+					StartTime: "2020-11-02T00:00:00Z",
+					EndTime:   "2020-11-03T00:00:00Z",
+					Teacher: &models.TeacherModel{
+						ID:          1,
+						FirstName:   "Anh",
+						LastName:    "Le",
+						DateOfBirth: "1998-11-02T00:00:00Z",
+					},
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("GetStudentCourses", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/students/student/{id}/courses"+testCase.query, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.GetStudentCourses)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_RecordGrade(t *testing.T) {
+	a := "A"
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		paramCourseID        string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.RecordGradeModel
+		mockServiceResult    *repositories.EnrollmentEntity
+		mockServiceError     error
+	}{
+		{
+			name:          "validate request body fail",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"letter": "A",
+				"score":  95,
+			},
+			expectedResponseBody: "either a letter or a score is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:          "score out of range",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"score": 101,
+			},
+			expectedResponseBody: "score must be between 0 and 100\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:          "letter not on scale",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"letter": "A",
+			},
+			expectedResponseBody: "grade is not on the grade scale\n",
+			expectedStatus:       http.StatusBadRequest,
+			mockServiceInput:     &models.RecordGradeModel{Letter: &a},
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrInvalidGrade,
+		},
+		{
+			name:          "record grade successfully",
+			paramID:       "1",
+			paramCourseID: "1",
+			requestBody: map[string]interface{}{
+				"letter": "A",
+			},
+			expectedResponseBody: "{\"success\":true,\"enrollment\":{\"id\":1,\"studentID\":1,\"courseID\":1,\"status\":\"completed\",\"gradeLetter\":\"A\"}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.RecordGradeModel{Letter: &a},
+			mockServiceResult: &repositories.EnrollmentEntity{
+				ID:          1,
+				StudentID:   1,
+				CourseID:    1,
+				Status:      "completed",
+				GradeLetter: &a,
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("RecordGrade", testCase.paramID, testCase.paramCourseID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/students/student/{id}/courses/{courseId}/grade", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+			chiCtx.URLParams.Add("courseId", testCase.paramCourseID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.RecordGrade)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetStudentGPA(t *testing.T) {
+	cumulative := 3.5
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceResult    *models.GPAModel
+		mockServiceError     error
+	}{
+		{
+			name:                 "student not found",
+			paramID:              "3",
+			expectedResponseBody: "student not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:                 "student without grades",
+			paramID:              "2",
+			expectedResponseBody: "{\"success\":true,\"cumulative\":null,\"courses\":0,\"terms\":[]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult: &models.GPAModel{
+				Terms: []*models.TermGPAModel{},
+			},
+			mockServiceError: nil,
+		},
+		{
+			name:                 "get student gpa successfully",
+			paramID:              "1",
+			expectedResponseBody: "{\"success\":true,\"cumulative\":3.5,\"courses\":2,\"terms\":[{\"term\":\"Fall 2020\",\"gpa\":3.5,\"courses\":2}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult: &models.GPAModel{
+				Cumulative: &cumulative,
+				Courses:    2,
+				Terms: []*models.TermGPAModel{
+					{Term: "Fall 2020", GPA: 3.5, Courses: 2},
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("GetStudentGPA", testCase.paramID).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/students/student/{id}/gpa", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.GetStudentGPA)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetStudentTranscript(t *testing.T) {
+	a := "A"
+	cumulative := 4.0
+	points := 4.0
+
+	transcript := &repositories.TranscriptEntity{
+		Student: &repositories.StudentEntity{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "Le", DateOfBirth: "1998-11-02T00:00:00Z"},
+		Courses: []*repositories.TranscriptCourseEntity{
+			{
+				EnrollmentID: 1,
+				CourseID:     1,
+				CourseName:   "Math",
+				Credits:      4,
+				Term:         "Fall 2020",
+				StartTime:    "2020-11-02T00:00:00Z",
+				EndTime:      "2020-11-03T00:00:00Z",
+				Status:       "completed",
+				GradeLetter:  &a,
+				GradePoints:  &points,
+			},
+		},
+		Terms: []*repositories.TermGPAEntity{
+			{Term: "Fall 2020", GPA: 4, Courses: 1},
+		},
+		CumulativeGPA: &cumulative,
+	}
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		accept               string
+		expectedResponseBody string
+		expectedContentType  string
+		expectedStatus       int
+		mockServiceResult    *repositories.TranscriptEntity
+		mockServiceError     error
+	}{
+		{
+			name:                 "student not found",
+			paramID:              "3",
+			accept:               "application/json",
+			expectedResponseBody: "student not found\n",
+			expectedContentType:  "text/plain; charset=utf-8",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    nil,
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:                 "unsupported media type",
+			paramID:              "1",
+			accept:               "application/pdf",
+			expectedResponseBody: "transcript is available as application/json, text/csv or text/html\n",
+			expectedContentType:  "text/plain; charset=utf-8",
+			expectedStatus:       http.StatusNotAcceptable,
+			mockServiceResult:    transcript,
+			mockServiceError:     nil,
+		},
+		{
+			name:                 "get transcript as json by default",
+			paramID:              "1",
+			accept:               "",
+			expectedResponseBody: "{\"success\":true,\"transcript\":{\"student\":{\"id\":1,\"studentID\":\"123456\",\"firstName\":\"Anh\",\"lastName\":\"Le\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\"},\"courses\":[{\"enrollmentID\":1,\"courseID\":1,\"courseName\":\"Math\",\"credits\":4,\"term\":\"Fall 2020\",\"startTime\":\"2020-11-02T00:00:00Z\",\"endTime\":\"2020-11-03T00:00:00Z\",\"status\":\"completed\",\"gradeLetter\":\"A\",\"gradeScore\":null,\"gradePoints\":4}],\"terms\":[{\"term\":\"Fall 2020\",\"gpa\":4,\"courses\":1}],\"cumulativeGPA\":4}}\n",
+			expectedContentType:  "application/json",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult:    transcript,
+			mockServiceError:     nil,
+		},
+		{
+			name:                 "get transcript as csv",
+			paramID:              "1",
+			accept:               "text/html;q=0.5, text/csv",
+			expectedResponseBody: "term,course_id,course,credits,start_time,end_time,status,grade,score,grade_points\nFall 2020,1,Math,4,2020-11-02T00:00:00Z,2020-11-03T00:00:00Z,completed,A,,4.00\ncumulative,,,,,,,,,4.00\n",
+			expectedContentType:  "text/csv; charset=utf-8",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult:    transcript,
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("GetStudentTranscript", testCase.paramID).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/students/student/{id}/transcript", nil)
+			if err != nil {
+				t.Error(err)
+			}
+			req.Header.Set("Accept", testCase.accept)
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.GetStudentTranscript)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedContentType, rr.Header().Get("Content-Type"))
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetStudentTranscriptHTML(t *testing.T) {
+	mockService := new(MockStudentService)
+	mockService.On("GetStudentTranscript", "1").Return(&repositories.TranscriptEntity{
+		Student: &repositories.StudentEntity{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "<Le>"},
+		Courses: []*repositories.TranscriptCourseEntity{
+			{EnrollmentID: 1, CourseID: 1, CourseName: "Math", Credits: 4, Term: "Fall 2020", Status: "active"},
+		},
+		Terms:   []*repositories.TermGPAEntity{},
+	}, nil)
+
+	studentHandler := StudentHandlers{
+		StudentServices: mockService,
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/students/student/{id}/transcript", nil)
+	if err != nil {
+		t.Error(err)
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,*/*;q=0.8")
+
+	chiCtx := chi.NewRouteContext()
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+	chiCtx.URLParams.Add("id", "1")
+
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(studentHandler.GetStudentTranscript)
+
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+	require.Contains(t, rr.Body.String(), "<h1>Academic Transcript</h1>")
+	require.Contains(t, rr.Body.String(), "Anh &lt;Le&gt;, student 123456")
+	require.Contains(t, rr.Body.String(), "<td>Math</td><td>4</td><td>active</td>")
+}
+
+func Test_SetCreditOverride(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.CreditOverrideModel
+		mockServiceError     error
+	}{
+		{
+			name:    "validate max credits fail",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"approvedBy": "advisor",
+			},
+			expectedResponseBody: "max credits must be greater than 0\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "validate approved by fail",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"maxCredits": 21,
+			},
+			expectedResponseBody: "approved by is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:    "student not found",
+			paramID: "4",
+			requestBody: map[string]interface{}{
+				"maxCredits": 21,
+				"approvedBy": "advisor",
+			},
+			expectedResponseBody: "student not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     &models.CreditOverrideModel{MaxCredits: 21, ApprovedBy: "advisor"},
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:    "set credit override successfully",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"maxCredits": 21,
+				"approvedBy": "advisor",
+			},
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.CreditOverrideModel{MaxCredits: 21, ApprovedBy: "advisor"},
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("SetCreditOverride", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/students/student/{id}/credit-override", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.SetCreditOverride)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_DeleteCreditOverride(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceError     error
+	}{
+		{
+			name:                 "credit override not found",
+			paramID:              "2",
+			expectedResponseBody: "credit override not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceError:     models.ErrCreditOverrideNotFound,
+		},
+		{
+			name:                 "delete credit override successfully",
+			paramID:              "1",
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("DeleteCreditOverride", testCase.paramID).Return(testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodDelete, "/students/student/{id}/credit-override", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.DeleteCreditOverride)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetStudentSchedule(t *testing.T) {
+	var noEvents []*models.ScheduleEventModel
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedContentType  string
+		expectedStatus       int
+		mockServiceResult    []*models.ScheduleEventModel
+		mockServiceError     error
+	}{
+		{
+			name:                 "student not found",
+			paramID:              "3",
+			expectedResponseBody: "student not found\n",
+			expectedContentType:  "text/plain; charset=utf-8",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    noEvents,
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:    "get schedule with no courses",
+			paramID: "2",
+			expectedResponseBody: "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//student_rest//schedule//EN\r\nCALSCALE:GREGORIAN\r\n" +
+				"METHOD:PUBLISH\r\nX-WR-CALNAME:Student 2 schedule\r\nEND:VCALENDAR\r\n",
+			expectedContentType: "text/calendar; charset=utf-8",
+			expectedStatus:      http.StatusOK,
+			mockServiceResult:   []*models.ScheduleEventModel{},
+			mockServiceError:    nil,
+		},
+		{
+			name:    "get schedule successfully",
+			paramID: "1",
+			expectedResponseBody: "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//student_rest//schedule//EN\r\nCALSCALE:GREGORIAN\r\n" +
+				"METHOD:PUBLISH\r\nX-WR-CALNAME:Student 1 schedule\r\n" +
+				"BEGIN:VEVENT\r\nUID:course-1-20201102T140000Z@student-rest\r\nDTSTAMP:STAMP\r\n" +
+				"DTSTART:20201102T140000Z\r\nDTEND:20201102T153000Z\r\nSUMMARY:Math\r\nDESCRIPTION:Teacher: Hoa Tran\r\nEND:VEVENT\r\n" +
+				"BEGIN:VEVENT\r\nUID:course-2@student-rest\r\nDTSTAMP:STAMP\r\n" +
+				"DTSTART:20201102T000000Z\r\nDTEND:20201203T000000Z\r\n" +
+				"SUMMARY:History of science\\, technology and society: a long introduction to\r\n  the modern world\r\nEND:VEVENT\r\n" +
+				"END:VCALENDAR\r\n",
+			expectedContentType: "text/calendar; charset=utf-8",
+			expectedStatus:      http.StatusOK,
+			mockServiceResult: []*models.ScheduleEventModel{
+				{
+					UID:        "course-1-20201102T140000Z@student-rest",
+					CourseID:   1,
+					CourseName: "Math",
+					Teacher:    "Hoa Tran",
+					StartTime:  "2020-11-02T09:00:00-05:00",
+					EndTime:    "2020-11-02T15:30:00Z",
+				},
+				{
+					UID:        "course-2@student-rest",
+					CourseID:   2,
+					CourseName: "History of science, technology and society: a long introduction to the modern world",
+					StartTime:  "2020-11-02T00:00:00Z",
+					EndTime:    "2020-12-03T00:00:00Z",
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	stamp := regexp.MustCompile(`DTSTAMP:\d{8}T\d{6}Z`)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("GetStudentSchedule", testCase.paramID).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/students/student/{id}/schedule.ics", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.GetStudentSchedule)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedContentType, rr.Header().Get("Content-Type"))
+			require.Equal(t, testCase.expectedResponseBody, stamp.ReplaceAllString(rr.Body.String(), "DTSTAMP:STAMP"))
+		})
+	}
+}
+
+func Test_GetStudentAudit(t *testing.T) {
+	var noAudit *repositories.AuditEntity
+	credits := 6
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceResult    *repositories.AuditEntity
+		mockServiceError     error
+	}{
+		{
+			name:                 "student not found",
+			paramID:              "3",
+			expectedResponseBody: "student not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceResult:    noAudit,
+			mockServiceError:     models.ErrStudentNotFound,
+		},
+		{
+			name:                 "student is not in a program",
+			paramID:              "2",
+			expectedResponseBody: "student is not in a program\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceResult:    noAudit,
+			mockServiceError:     models.ErrStudentHasNoProgram,
+		},
+		{
+			name:                 "get student audit successfully",
+			paramID:              "1",
+			expectedResponseBody: "{\"success\":true,\"audit\":{\"student\":{\"id\":1,\"studentID\":\"123456\",\"firstName\":\"Anh\",\"lastName\":\"Le\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\"},\"program\":{\"id\":1,\"departmentID\":1,\"code\":\"BSC-PHYS\",\"name\":\"Physics\",\"degree\":\"BSc\"},\"satisfied\":[],\"inProgress\":[{\"id\":2,\"name\":\"Electives\",\"kind\":\"credits\",\"status\":\"in-progress\",\"minGrade\":null,\"requiredCredits\":6,\"earnedCredits\":3,\"inProgressCredits\":3,\"courses\":[{\"courseID\":3,\"courseName\":\"Art\",\"credits\":3,\"status\":\"satisfied\"},{\"courseID\":4,\"courseName\":\"Music\",\"credits\":3,\"status\":\"in-progress\"}]}],\"missing\":[]}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceResult: &repositories.AuditEntity{
+				Student:   &repositories.StudentEntity{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "Le", DateOfBirth: "1998-11-02T00:00:00Z"},
+				Program:   &repositories.ProgramEntity{ID: 1, DepartmentID: 1, Code: "BSC-PHYS", Name: "Physics", Degree: "BSc"},
+				Satisfied: []*repositories.RequirementAuditEntity{},
+				InProgress: []*repositories.RequirementAuditEntity{
+					{ID: 2, Name: "Electives", Kind: models.RequirementKindCredits, Status: models.AuditStatusInProgress,
+						RequiredCredits: &credits, EarnedCredits: 3, InProgressCredits: 3, Courses: []*repositories.CourseAuditEntity{
+							{CourseID: 3, CourseName: "Art", Credits: 3, Status: models.AuditStatusSatisfied},
+							{CourseID: 4, CourseName: "Music", Credits: 3, Status: models.AuditStatusInProgress},
+						}},
+				},
+				Missing: []*repositories.RequirementAuditEntity{},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockStudentService)
+			mockService.On("GetStudentAudit", testCase.paramID).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			studentHandler := StudentHandlers{
+				StudentServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/students/student/{id}/audit", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(studentHandler.GetStudentAudit)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"student_rest/models"
+	"time"
 
 	"github.com/go-chi/chi"
 	"student_rest/services"
@@ -31,7 +32,7 @@ func (_self TeacherHandlers) CreateTeacher(w http.ResponseWriter, r *http.Reques
 	result, err := _self.TeacherServices.CreateTeacher(&convertedTeacher)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -47,9 +48,28 @@ func transformTeacherRequestToTeacherModel(request TeacherRequest) models.Teache
 		FirstName:   request.FirstName,
 		LastName:    request.LastName,
 		DateOfBirth: request.DateOfBirth,
+
+		DepartmentID: request.DepartmentID,
 	}
 }
 
+// GetTeachers lists the teachers, the departmentId query parameter keeps the teachers of one department
+func (_self TeacherHandlers) GetTeachers(w http.ResponseWriter, r *http.Request) {
+	result, err := _self.TeacherServices.GetTeachers(&models.TeacherFilterModel{
+		DepartmentID: r.URL.Query().Get("departmentId"),
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(TeachersResponse{
+		Success:  true,
+		Teachers: result,
+	})
+}
+
 func (_self TeacherHandlers) GetTeacherByID(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	result, err := _self.TeacherServices.GetTeacherByID(id)
@@ -65,11 +85,26 @@ func (_self TeacherHandlers) GetTeacherByID(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// DeleteTeacher removes the teacher, the policy and reassignTo query parameters say what happens to their courses
 func (_self TeacherHandlers) DeleteTeacher(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 
-	if err := _self.TeacherServices.DeleteTeacher(id); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	query := r.URL.Query()
+	deletion := TeacherDeletionRequest{
+		Policy:     query.Get("policy"),
+		ReassignTo: query.Get("reassignTo"),
+	}
+
+	if err := deletion.validation(id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := _self.TeacherServices.DeleteTeacher(id, &models.TeacherDeletionModel{
+		Policy:     deletion.Policy,
+		ReassignTo: deletion.ReassignTo,
+	}); err != nil {
+		writeError(w, err)
 		return
 	}
 
@@ -97,7 +132,7 @@ func (_self TeacherHandlers) UpdateTeacher(w http.ResponseWriter, r *http.Reques
 	err := _self.TeacherServices.UpdateTeacher(id, &convertedTeacher)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -105,3 +140,105 @@ func (_self TeacherHandlers) UpdateTeacher(w http.ResponseWriter, r *http.Reques
 		Success: true,
 	})
 }
+
+// GetTeacherSchedule lists the sessions the teacher teaches as an agenda, optionally between the from and to dates
+func (_self TeacherHandlers) GetTeacherSchedule(w http.ResponseWriter, r *http.Request) {
+	result, ok := _self.getTeacherSchedule(w, r)
+	if !ok {
+		return
+	}
+
+	events := make([]*ScheduleEventResponse, 0, len(result))
+	for _, event := range result {
+		events = append(events, &ScheduleEventResponse{
+			UID:        event.UID,
+			CourseID:   event.CourseID,
+			CourseName: event.CourseName,
+			Teacher:    event.Teacher,
+			StartTime:  event.StartTime,
+			EndTime:    event.EndTime,
+		})
+	}
+
+	json.NewEncoder(w).Encode(ScheduleResponse{
+		Success: true,
+		Events:  events,
+	})
+}
+
+// GetTeacherScheduleCalendar serves the agenda of the teacher as an iCalendar feed
+func (_self TeacherHandlers) GetTeacherScheduleCalendar(w http.ResponseWriter, r *http.Request) {
+	result, ok := _self.getTeacherSchedule(w, r)
+	if !ok {
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	w.Header().Set("Content-Type", mediaTypeCalendar+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="teacher-schedule-`+id+`.ics"`)
+	if err := writeCalendar(w, "Teacher "+id+" schedule", result, time.Now()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// getTeacherSchedule reads the schedule of both schedule endpoints, it writes the error and returns false when it fails
+func (_self TeacherHandlers) getTeacherSchedule(w http.ResponseWriter, r *http.Request) ([]*models.ScheduleEventModel, bool) {
+	id := chi.URLParam(r, "id")
+
+	query := r.URL.Query()
+	filter := ScheduleFilterRequest{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+	}
+
+	if err := filter.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	result, err := _self.TeacherServices.GetTeacherSchedule(id, &models.ScheduleFilterModel{
+		From: filter.From,
+		To:   filter.To,
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return nil, false
+	}
+	return result, true
+}
+
+// GetTeacherCourses lists the courses of the teacher, filtered by the termId, from, to and status query parameters
+func (_self TeacherHandlers) GetTeacherCourses(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	query := r.URL.Query()
+	filter := TeacherCourseFilterRequest{
+		TermID: query.Get("termId"),
+		From:   query.Get("from"),
+		To:     query.Get("to"),
+		Status: query.Get("status"),
+	}
+
+	if err := filter.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := _self.TeacherServices.GetTeacherCourses(id, &models.CourseFilterModel{
+		TermID: filter.TermID,
+		From:   filter.From,
+		To:     filter.To,
+		Status: filter.Status,
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(CoursesResponse{
+		Success: true,
+		Courses: result,
+	})
+}
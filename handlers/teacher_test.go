@@ -29,8 +29,13 @@ func (m *MockTeacherService) GetTeacherByID(id string) (*repositories.TeacherEnt
 	return returnArgs.Get(0).(*repositories.TeacherEntity), returnArgs.Error(1)
 }
 
-func (m *MockTeacherService) DeleteTeacher(id string) error {
-	returnArgs := m.Called(id)
+func (m *MockTeacherService) GetTeachers(filter *models.TeacherFilterModel) ([]*repositories.TeacherEntity, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*repositories.TeacherEntity), returnArgs.Error(1)
+}
+
+func (m *MockTeacherService) DeleteTeacher(id string, deletion *models.TeacherDeletionModel) error {
+	returnArgs := m.Called(id, deletion)
 	return returnArgs.Error(0)
 }
 
@@ -39,7 +44,18 @@ func (m *MockTeacherService) UpdateTeacher(id string, teacher *models.TeacherMod
 	return returnArgs.Error(0)
 }
 
+func (m *MockTeacherService) GetTeacherCourses(id string, filter *models.CourseFilterModel) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(id, filter)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
+func (m *MockTeacherService) GetTeacherSchedule(id string, filter *models.ScheduleFilterModel) ([]*models.ScheduleEventModel, error) {
+	returnArgs := m.Called(id, filter)
+	return returnArgs.Get(0).([]*models.ScheduleEventModel), returnArgs.Error(1)
+}
+
 func Test_CreateTeacher(t *testing.T) {
+	departmentID := 3
 	testCases := []struct {
 		name                 string
 		requestBody          map[string]interface{}
@@ -85,22 +101,25 @@ func Test_CreateTeacher(t *testing.T) {
 		{
 			name: "create teacher successfully",
 			requestBody: map[string]interface{}{
-				"firstName":   "Mai",
-				"lastName":    "Dao",
-				"dateOfBirth": "1998-11-02T00:00:00Z",
+				"firstName":    "Mai",
+				"lastName":     "Dao",
+				"dateOfBirth":  "1998-11-02T00:00:00Z",
+				"departmentID": 3,
 			},
-			expectedResponseBody: "{\"success\":true,\"teacher\":{\"id\":1,\"firstName\":\"Mai\",\"lastName\":\"Dao\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\"}}\n",
+			expectedResponseBody: "{\"success\":true,\"teacher\":{\"id\":1,\"firstName\":\"Mai\",\"lastName\":\"Dao\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\",\"archivedAt\":null,\"departmentID\":3}}\n",
 			expectedStatus:       http.StatusOK,
 			mockServiceInput: &models.TeacherModel{
-				FirstName:   "Mai",
-				LastName:    "Dao",
-				DateOfBirth: "1998-11-02T00:00:00Z",
+				FirstName:    "Mai",
+				LastName:     "Dao",
+				DateOfBirth:  "1998-11-02T00:00:00Z",
+				DepartmentID: &departmentID,
 			},
 			mockServiceResult: &repositories.TeacherEntity{
-				ID:          1,
-				FirstName:   "Mai",
-				LastName:    "Dao",
-				DateOfBirth: "1998-11-02T00:00:00Z",
+				ID:           1,
+				FirstName:    "Mai",
+				LastName:     "Dao",
+				DateOfBirth:  "1998-11-02T00:00:00Z",
+				DepartmentID: &departmentID,
 			},
 			mockServiceError: nil,
 		},
@@ -158,7 +177,7 @@ func Test_GetTeacherByID(t *testing.T) {
 		{
 			name:                 "get teacher by id successfully",
 			paramID:              "2",
-			expectedResponseBody: "{\"success\":true,\"teacher\":{\"id\":2,\"firstName\":\"Mai\",\"lastName\":\"Dao\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\"}}\n",
+			expectedResponseBody: "{\"success\":true,\"teacher\":{\"id\":2,\"firstName\":\"Mai\",\"lastName\":\"Dao\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\",\"archivedAt\":null,\"departmentID\":null}}\n",
 			expectedStatus:       http.StatusOK,
 			mockServiceInput:     "2",
 			mockServiceResult: &repositories.TeacherEntity{
@@ -205,25 +224,82 @@ func Test_DeleteTeacher(t *testing.T) {
 	testCases := []struct {
 		name                 string
 		paramID              string
+		query                string
 		expectedResponseBody string
 		expectedStatus       int
-		mockServiceInput     string
+		mockServiceInput     *models.TeacherDeletionModel
 		mockServiceError     error
 	}{
+		{
+			name:                 "invalid deletion policy",
+			paramID:              "1",
+			query:                "?policy=cascade",
+			expectedResponseBody: "invalid deletion policy: cascade\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "reassign without a teacher",
+			paramID:              "1",
+			query:                "?policy=reassign",
+			expectedResponseBody: "reassign to is required\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "reassign to the same teacher",
+			paramID:              "1",
+			query:                "?policy=reassign&reassignTo=1",
+			expectedResponseBody: "reassign to must be another teacher\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "reassign to with another policy",
+			paramID:              "1",
+			query:                "?policy=archive&reassignTo=2",
+			expectedResponseBody: "reassign to is only used with the reassign policy\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
 		{
 			name:                 "delete teacher fail",
 			paramID:              "1",
 			expectedResponseBody: "delete teacher fail\n",
 			expectedStatus:       http.StatusInternalServerError,
-			mockServiceInput:     "1",
+			mockServiceInput:     &models.TeacherDeletionModel{},
 			mockServiceError:     errors.New("delete teacher fail"),
 		},
+		{
+			name:                 "teacher still has courses",
+			paramID:              "1",
+			expectedResponseBody: "{\"success\":false,\"error\":\"teacher still teaches courses: Math\",\"details\":[{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":0,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":null,\"Teacher\":null}]}\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     &models.TeacherDeletionModel{},
+			mockServiceError: &models.TeacherHasCoursesError{Courses: []*models.CourseModel{
+				{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"},
+			}},
+		},
+		{
+			name:                 "reassign to an archived teacher",
+			paramID:              "1",
+			query:                "?policy=reassign&reassignTo=2",
+			expectedResponseBody: "teacher is archived\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput:     &models.TeacherDeletionModel{Policy: "reassign", ReassignTo: "2"},
+			mockServiceError:     models.ErrTeacherArchived,
+		},
 		{
 			name:                 "delete teacher successfully",
 			paramID:              "2",
 			expectedResponseBody: "{\"success\":true}\n",
 			expectedStatus:       http.StatusOK,
-			mockServiceInput:     "2",
+			mockServiceInput:     &models.TeacherDeletionModel{},
+			mockServiceError:     nil,
+		},
+		{
+			name:                 "archive teacher successfully",
+			paramID:              "2",
+			query:                "?policy=archive",
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.TeacherDeletionModel{Policy: "archive"},
 			mockServiceError:     nil,
 		},
 	}
@@ -231,13 +307,13 @@ func Test_DeleteTeacher(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			mockService := new(MockTeacherService)
-			mockService.On("DeleteTeacher", testCase.mockServiceInput).Return(testCase.mockServiceError)
+			mockService.On("DeleteTeacher", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceError)
 
 			teacherHandler := TeacherHandlers{
 				TeacherServices: mockService,
 			}
 
-			req, err := http.NewRequest(http.MethodDelete, "/teachers/teacher/{id}", nil)
+			req, err := http.NewRequest(http.MethodDelete, "/teachers/teacher/{id}"+testCase.query, nil)
 			if err != nil {
 				t.Error(err)
 			}
@@ -358,3 +434,246 @@ func Test_UpdateTeacher(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetTeacherSchedule(t *testing.T) {
+	var noEvents []*models.ScheduleEventModel
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		query                string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.ScheduleFilterModel
+		mockServiceResult    []*models.ScheduleEventModel
+		mockServiceError     error
+	}{
+		{
+			name:                 "invalid from date",
+			paramID:              "1",
+			query:                "?from=2020-11",
+			expectedResponseBody: "from must be a YYYY-MM-DD date\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "to before from",
+			paramID:              "1",
+			query:                "?from=2020-11-09&to=2020-11-02",
+			expectedResponseBody: "to must not be before from\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "teacher not found",
+			paramID:              "3",
+			query:                "",
+			expectedResponseBody: "teacher not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     &models.ScheduleFilterModel{},
+			mockServiceResult:    noEvents,
+			mockServiceError:     models.ErrTeacherNotFound,
+		},
+		{
+			name:                 "get teacher schedule successfully",
+			paramID:              "1",
+			query:                "?from=2020-11-02&to=2020-11-08",
+			expectedResponseBody: "{\"success\":true,\"events\":[{\"uid\":\"course-1-20201102T140000Z@student-rest\",\"courseID\":1,\"courseName\":\"Math\",\"teacher\":\"Hoa Tran\",\"startTime\":\"2020-11-02T14:00:00Z\",\"endTime\":\"2020-11-02T15:30:00Z\"}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.ScheduleFilterModel{From: "2020-11-02", To: "2020-11-08"},
+			mockServiceResult: []*models.ScheduleEventModel{
+				{
+					UID:        "course-1-20201102T140000Z@student-rest",
+					CourseID:   1,
+					CourseName: "Math",
+					Teacher:    "Hoa Tran",
+					StartTime:  "2020-11-02T14:00:00Z",
+					EndTime:    "2020-11-02T15:30:00Z",
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockTeacherService)
+			mockService.On("GetTeacherSchedule", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			teacherHandler := TeacherHandlers{
+				TeacherServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/teachers/teacher/{id}/schedule"+testCase.query, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(teacherHandler.GetTeacherSchedule)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetTeacherCourses(t *testing.T) {
+	var noCourses []*models.CourseModel
+	enrolled := 12
+
+	testCases := []struct {
+		name                 string
+		paramID              string
+		query                string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.CourseFilterModel
+		mockServiceResult    []*models.CourseModel
+		mockServiceError     error
+	}{
+		{
+			name:                 "invalid status",
+			paramID:              "1",
+			query:                "?status=upcoming",
+			expectedResponseBody: "status must be active or past\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "invalid to date",
+			paramID:              "1",
+			query:                "?to=11/03/2020",
+			expectedResponseBody: "to must be a YYYY-MM-DD date\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name:                 "teacher not found",
+			paramID:              "3",
+			query:                "",
+			expectedResponseBody: "teacher not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     &models.CourseFilterModel{},
+			mockServiceResult:    noCourses,
+			mockServiceError:     models.ErrTeacherNotFound,
+		},
+		{
+			name:                 "get teacher courses successfully",
+			paramID:              "1",
+			query:                "?termId=1&from=2020-11-01&to=2020-11-30&status=past",
+			expectedResponseBody: "{\"success\":true,\"courses\":[{\"ID\":1,\"Name\":\"Math\",\"StartTime\":\"2020-11-02T00:00:00Z\",\"EndTime\":\"2020-11-03T00:00:00Z\",\"Capacity\":null,\"Credits\":3,\"RegistrationOpensAt\":null,\"RegistrationClosesAt\":null,\"Term\":{\"ID\":1,\"Name\":\"Fall 2020\",\"StartDate\":\"\",\"EndDate\":\"\",\"Status\":\"\"},\"Teacher\":{\"ID\":1,\"FirstName\":\"Anh\",\"LastName\":\"Le\",\"DateOfBirth\":\"\"},\"Enrolled\":12}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.CourseFilterModel{TermID: "1", From: "2020-11-01", To: "2020-11-30", Status: "past"},
+			mockServiceResult: []*models.CourseModel{
+				{
+					ID:        1,
+					Name:      "Math",
+					StartTime: "2020-11-02T00:00:00Z",
+					EndTime:   "2020-11-03T00:00:00Z",
+					Credits:   3,
+					Term:      &models.TermModel{ID: 1, Name: "Fall 2020"},
+					Teacher:   &models.TeacherModel{ID: 1, FirstName: "Anh", LastName: "Le"},
+					Enrolled:  &enrolled,
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockTeacherService)
+			mockService.On("GetTeacherCourses", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			teacherHandler := TeacherHandlers{
+				TeacherServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/teachers/teacher/{id}/courses"+testCase.query, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(teacherHandler.GetTeacherCourses)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_GetTeachers(t *testing.T) {
+	var noTeachers []*repositories.TeacherEntity
+	departmentID := 1
+
+	testCases := []struct {
+		name                 string
+		query                string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.TeacherFilterModel
+		mockServiceResult    []*repositories.TeacherEntity
+		mockServiceError     error
+	}{
+		{
+			name:                 "department not found",
+			query:                "?departmentId=9",
+			expectedResponseBody: "department not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput:     &models.TeacherFilterModel{DepartmentID: "9"},
+			mockServiceResult:    noTeachers,
+			mockServiceError:     models.ErrDepartmentNotFound,
+		},
+		{
+			name:                 "get teachers of the department successfully",
+			query:                "?departmentId=1",
+			expectedResponseBody: "{\"success\":true,\"teachers\":[{\"id\":1,\"firstName\":\"Anh\",\"lastName\":\"Le\",\"dateOfBirth\":\"1998-11-02T00:00:00Z\",\"archivedAt\":null,\"departmentID\":1}]}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput:     &models.TeacherFilterModel{DepartmentID: "1"},
+			mockServiceResult: []*repositories.TeacherEntity{
+				{
+					ID:           1,
+					FirstName:    "Anh",
+					LastName:     "Le",
+					DateOfBirth:  "1998-11-02T00:00:00Z",
+					DepartmentID: &departmentID,
+				},
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockTeacherService)
+			mockService.On("GetTeachers", testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			teacherHandler := TeacherHandlers{
+				TeacherServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/teachers"+testCase.query, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(teacherHandler.GetTeachers)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
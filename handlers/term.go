@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"student_rest/models"
+
+	"github.com/go-chi/chi"
+	"student_rest/services"
+)
+
+type TermHandlers struct {
+	services.TermServices
+}
+
+func (_self TermHandlers) CreateTerm(w http.ResponseWriter, r *http.Request) {
+	var term TermRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&term); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := term.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedTerm := transformTermRequestToTermModel(term)
+	result, err := _self.TermServices.CreateTerm(&convertedTerm)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(TermResponse{
+		Success: true,
+		Term:    result,
+	})
+}
+
+// transformTermRequestToTermModel converts the request, a term without a status starts as planned
+func transformTermRequestToTermModel(request TermRequest) models.TermModel {
+	status := request.Status
+	if status == "" {
+		status = models.TermStatusPlanned
+	}
+	return models.TermModel{
+		Name:      request.Name,
+		StartDate: request.StartDate,
+		EndDate:   request.EndDate,
+		Status:    status,
+	}
+}
+
+func (_self TermHandlers) GetTerms(w http.ResponseWriter, r *http.Request) {
+	result, err := _self.TermServices.GetTerms()
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(TermsResponse{
+		Success: true,
+		Terms:   result,
+	})
+}
+
+func (_self TermHandlers) GetTermByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	result, err := _self.TermServices.GetTermByID(id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(TermResponse{
+		Success: true,
+		Term:    result,
+	})
+}
+
+func (_self TermHandlers) UpdateTerm(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var term TermRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&term); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := term.validation(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convertedTerm := transformTermRequestToTermModel(term)
+	if err := _self.TermServices.UpdateTerm(id, &convertedTerm); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
+
+func (_self TermHandlers) DeleteTerm(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	if err := _self.TermServices.DeleteTerm(id); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Success: true,
+	})
+}
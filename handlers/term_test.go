@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockTermService struct {
+	mock.Mock
+}
+
+func (m *MockTermService) CreateTerm(term *models.TermModel) (*repositories.TermEntity, error) {
+	returnArgs := m.Called(term)
+	return returnArgs.Get(0).(*repositories.TermEntity), returnArgs.Error(1)
+}
+
+func (m *MockTermService) GetTerms() ([]*repositories.TermEntity, error) {
+	returnArgs := m.Called()
+	return returnArgs.Get(0).([]*repositories.TermEntity), returnArgs.Error(1)
+}
+
+func (m *MockTermService) GetTermByID(id string) (*repositories.TermEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.TermEntity), returnArgs.Error(1)
+}
+
+func (m *MockTermService) UpdateTerm(id string, term *models.TermModel) error {
+	returnArgs := m.Called(id, term)
+	return returnArgs.Error(0)
+}
+
+func (m *MockTermService) DeleteTerm(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+func Test_CreateTerm(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.TermModel
+		mockServiceResult    *repositories.TermEntity
+		mockServiceError     error
+	}{
+		{
+			name: "validate request body fail",
+			requestBody: map[string]interface{}{
+				"name":      "Fall 2020",
+				"startDate": "2020-12-31",
+				"endDate":   "2020-08-15",
+			},
+			expectedResponseBody: "term must start before it ends\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name: "invalid term status",
+			requestBody: map[string]interface{}{
+				"name":      "Fall 2020",
+				"startDate": "2020-08-15",
+				"endDate":   "2020-12-31",
+				"status":    "open",
+			},
+			expectedResponseBody: "invalid term status: open\n",
+			expectedStatus:       http.StatusBadRequest,
+		},
+		{
+			name: "create term successfully",
+			requestBody: map[string]interface{}{
+				"name":      "Fall 2020",
+				"startDate": "2020-08-15",
+				"endDate":   "2020-12-31",
+			},
+			expectedResponseBody: "{\"success\":true,\"term\":{\"id\":1,\"name\":\"Fall 2020\",\"startDate\":\"2020-08-15T00:00:00Z\",\"endDate\":\"2020-12-31T00:00:00Z\",\"status\":\"planned\"}}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceInput: &models.TermModel{
+				Name:      "Fall 2020",
+				StartDate: "2020-08-15",
+				EndDate:   "2020-12-31",
+				Status:    models.TermStatusPlanned,
+			},
+			mockServiceResult: &repositories.TermEntity{
+				ID:        1,
+				Name:      "Fall 2020",
+				StartDate: "2020-08-15T00:00:00Z",
+				EndDate:   "2020-12-31T00:00:00Z",
+				Status:    models.TermStatusPlanned,
+			},
+			mockServiceError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockTermService)
+			mockService.On("CreateTerm", testCase.mockServiceInput).Return(testCase.mockServiceResult, testCase.mockServiceError)
+
+			termHandler := TermHandlers{
+				TermServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPost, "/terms", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(termHandler.CreateTerm)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_UpdateTerm(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		requestBody          map[string]interface{}
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceInput     *models.TermModel
+		mockServiceError     error
+	}{
+		{
+			name:    "term not found",
+			paramID: "9",
+			requestBody: map[string]interface{}{
+				"name":      "Fall 2020",
+				"startDate": "2020-08-15",
+				"endDate":   "2020-12-31",
+				"status":    "active",
+			},
+			expectedResponseBody: "term not found\n",
+			expectedStatus:       http.StatusNotFound,
+			mockServiceInput: &models.TermModel{
+				Name:      "Fall 2020",
+				StartDate: "2020-08-15",
+				EndDate:   "2020-12-31",
+				Status:    models.TermStatusActive,
+			},
+			mockServiceError: models.ErrTermNotFound,
+		},
+		{
+			name:    "new dates exclude a course",
+			paramID: "1",
+			requestBody: map[string]interface{}{
+				"name":      "Fall 2020",
+				"startDate": "2020-08-15",
+				"endDate":   "2020-10-31",
+				"status":    "active",
+			},
+			expectedResponseBody: "term dates must cover all of its courses\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceInput: &models.TermModel{
+				Name:      "Fall 2020",
+				StartDate: "2020-08-15",
+				EndDate:   "2020-10-31",
+				Status:    models.TermStatusActive,
+			},
+			mockServiceError: models.ErrTermExcludesCourses,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockTermService)
+			mockService.On("UpdateTerm", testCase.paramID, testCase.mockServiceInput).Return(testCase.mockServiceError)
+
+			termHandler := TermHandlers{
+				TermServices: mockService,
+			}
+
+			requestBody, err := json.Marshal(testCase.requestBody)
+			if err != nil {
+				t.Error(err)
+			}
+			req, err := http.NewRequest(http.MethodPut, "/terms/term/{id}", bytes.NewBuffer(requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(termHandler.UpdateTerm)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
+
+func Test_DeleteTerm(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		paramID              string
+		expectedResponseBody string
+		expectedStatus       int
+		mockServiceError     error
+	}{
+		{
+			name:                 "term has courses",
+			paramID:              "1",
+			expectedResponseBody: "term still has courses\n",
+			expectedStatus:       http.StatusConflict,
+			mockServiceError:     models.ErrTermHasCourses,
+		},
+		{
+			name:                 "delete term successfully",
+			paramID:              "2",
+			expectedResponseBody: "{\"success\":true}\n",
+			expectedStatus:       http.StatusOK,
+			mockServiceError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockService := new(MockTermService)
+			mockService.On("DeleteTerm", testCase.paramID).Return(testCase.mockServiceError)
+
+			termHandler := TermHandlers{
+				TermServices: mockService,
+			}
+
+			req, err := http.NewRequest(http.MethodDelete, "/terms/term/{id}", nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			chiCtx := chi.NewRouteContext()
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			chiCtx.URLParams.Add("id", testCase.paramID)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(termHandler.DeleteTerm)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.Equal(t, testCase.expectedResponseBody, rr.Body.String())
+		})
+	}
+}
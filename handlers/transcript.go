@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"html/template"
+	"io"
+	"strconv"
+	"student_rest/repositories"
+)
+
+const (
+	mediaTypeJSON = "application/json"
+	mediaTypeCSV  = "text/csv"
+	mediaTypeHTML = "text/html"
+)
+
+var transcriptCSVHeader = []string{
+	"term", "course_id", "course", "credits", "start_time", "end_time", "status", "grade", "score", "grade_points",
+}
+
+// writeTranscriptCSV writes one row per course and a last row with the cumulative GPA
+func writeTranscriptCSV(w io.Writer, transcript *repositories.TranscriptEntity) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(transcriptCSVHeader); err != nil {
+		return err
+	}
+
+	for _, course := range transcript.Courses {
+		record := []string{
+			course.Term,
+			strconv.Itoa(course.CourseID),
+			course.CourseName,
+			strconv.Itoa(course.Credits),
+			course.StartTime,
+			course.EndTime,
+			course.Status,
+			formatOptionalString(course.GradeLetter),
+			formatOptionalFloat(course.GradeScore),
+			formatOptionalFloat(course.GradePoints),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cumulative := []string{"cumulative", "", "", "", "", "", "", "", "", formatOptionalFloat(transcript.CumulativeGPA)}
+	if err := writer.Write(cumulative); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatOptionalString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func formatOptionalFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', 2, 64)
+}
+
+var transcriptTemplate = template.Must(template.New("transcript").Funcs(template.FuncMap{
+	"optionalString": formatOptionalString,
+	"optionalFloat":  formatOptionalFloat,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Transcript - {{.Student.FirstName}} {{.Student.LastName}}</title>
+<style>
+body { font-family: serif; margin: 2em; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1.5em; }
+th, td { border: 1px solid #444; padding: 4px 8px; text-align: left; }
+@media print { body { margin: 0; } }
+</style>
+</head>
+<body>
+<h1>Academic Transcript</h1>
+<p>{{.Student.FirstName}} {{.Student.LastName}}, student {{.Student.StudentID}}, born {{.Student.DateOfBirth}}</p>
+<table>
+<thead>
+<tr><th>Term</th><th>Course</th><th>Credits</th><th>Status</th><th>Grade</th><th>Score</th><th>Grade points</th></tr>
+</thead>
+<tbody>
+{{range .Courses}}<tr><td>{{.Term}}</td><td>{{.CourseName}}</td><td>{{.Credits}}</td><td>{{.Status}}</td><td>{{optionalString .GradeLetter}}</td><td>{{optionalFloat .GradeScore}}</td><td>{{optionalFloat .GradePoints}}</td></tr>
+{{end}}</tbody>
+</table>
+<table>
+<thead>
+<tr><th>Term</th><th>Graded courses</th><th>GPA</th></tr>
+</thead>
+<tbody>
+{{range .Terms}}<tr><td>{{.Term}}</td><td>{{.Courses}}</td><td>{{printf "%.2f" .GPA}}</td></tr>
+{{end}}</tbody>
+</table>
+<p><strong>Cumulative GPA:</strong> {{optionalFloat .CumulativeGPA}}</p>
+</body>
+</html>
+`))
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"student_rest/db"
 	"student_rest/routes"
+	// Meeting patterns use IANA time zones and the runtime image ships no zoneinfo
+	_ "time/tzdata"
 )
 
 func main() {
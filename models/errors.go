@@ -0,0 +1,370 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrStudentNotFound   = errors.New("student not found")
+	ErrTeacherNotFound   = errors.New("teacher not found")
+	ErrTeacherArchived   = errors.New("teacher is archived")
+	ErrCourseNotFound    = errors.New("course not found")
+	ErrAlreadyEnrolled   = errors.New("student is already enrolled in this course")
+	ErrNotEnrolled       = errors.New("student is not enrolled in this course")
+	ErrAlreadyWaitlisted = errors.New("student is already on the waitlist for this course")
+
+	ErrPrerequisiteNotFound = errors.New("prerequisite not found")
+	ErrPrerequisiteExists   = errors.New("course already has this prerequisite")
+	ErrPrerequisiteCycle    = errors.New("prerequisite would create a cycle")
+
+	ErrBulkEnrollmentRolledBack = errors.New("not enrolled because another student in the batch failed")
+
+	ErrEnrollmentNotFound      = errors.New("enrollment not found")
+	ErrEnrollmentStatusChanged = errors.New("enrollment status was changed by another request")
+	ErrCourseFull              = errors.New("course is full")
+
+	ErrRegistrationOverrideNotFound = errors.New("registration override not found")
+
+	ErrInvalidGrade = errors.New("grade is not on the grade scale")
+
+	ErrCreditOverrideNotFound = errors.New("credit override not found")
+
+	ErrTermNotFound        = errors.New("term not found")
+	ErrTermHasCourses      = errors.New("term still has courses")
+	ErrTermExcludesCourses = errors.New("term dates must cover all of its courses")
+
+	ErrSessionOutsideCourse = errors.New("session date is outside the course dates")
+	ErrSessionNotScheduled  = errors.New("no session of this course is scheduled on the session date")
+
+	ErrMeetingPatternNotFound = errors.New("meeting pattern not found")
+	ErrNoSessions             = errors.New("meeting pattern has no sessions between the course dates")
+
+	ErrReassignTeacherNotFound = errors.New("teacher to reassign the courses to not found")
+
+	ErrCourseTeacherNotFound = errors.New("teacher doesn't teach this course")
+	ErrPrimaryTeacher        = errors.New("teacher is the primary teacher of this course, assign the course to another teacher instead")
+
+	ErrRoomNotFound        = errors.New("room not found")
+	ErrRoomExists          = errors.New("building already has a room with this number")
+	ErrRoomHasBookings     = errors.New("room still has bookings")
+	ErrRoomBookingNotFound = errors.New("room booking not found")
+
+	ErrDepartmentNotFound = errors.New("department not found")
+	ErrDepartmentExists   = errors.New("a department with this code already exists")
+	ErrDepartmentInUse    = errors.New("department still has programs, teachers or courses")
+	ErrProgramNotFound    = errors.New("program not found")
+	ErrProgramExists      = errors.New("a program with this code already exists")
+	ErrProgramHasStudents = errors.New("program still has students")
+
+	ErrProgramRequirementNotFound = errors.New("program requirement not found")
+	ErrStudentHasNoProgram        = errors.New("student is not in a program")
+)
+
+const (
+	RegistrationNotOpen = "REGISTRATION_NOT_OPEN"
+	RegistrationClosed  = "REGISTRATION_CLOSED"
+)
+
+const (
+	TeacherCourseLimit      = "TEACHER_COURSE_LIMIT"
+	TeacherWeeklyHoursLimit = "TEACHER_WEEKLY_HOURS_LIMIT"
+)
+
+// Codes of the reasons a student of a bulk enrollment wasn't enrolled, a RegistrationWindowError keeps its own code
+const (
+	EnrollmentStudentNotFound      = "STUDENT_NOT_FOUND"
+	EnrollmentAlreadyEnrolled      = "ALREADY_ENROLLED"
+	EnrollmentAlreadyWaitlisted    = "ALREADY_WAITLISTED"
+	EnrollmentScheduleConflict     = "SCHEDULE_CONFLICT"
+	EnrollmentMissingPrerequisites = "MISSING_PREREQUISITES"
+	EnrollmentCreditLimitExceeded  = "CREDIT_LIMIT_EXCEEDED"
+	EnrollmentRolledBack           = "BULK_ENROLLMENT_ROLLED_BACK"
+)
+
+// EnrollmentErrorCode returns the code of the reason the student wasn't enrolled, empty when err has no code
+func EnrollmentErrorCode(err error) string {
+	var registrationWindow *RegistrationWindowError
+	var scheduleConflict *ScheduleConflictError
+	var missingPrerequisites *MissingPrerequisitesError
+	var creditLimit *CreditLimitExceededError
+	switch {
+	case errors.As(err, &registrationWindow):
+		return registrationWindow.Code
+	case errors.As(err, &scheduleConflict):
+		return EnrollmentScheduleConflict
+	case errors.As(err, &missingPrerequisites):
+		return EnrollmentMissingPrerequisites
+	case errors.As(err, &creditLimit):
+		return EnrollmentCreditLimitExceeded
+	case errors.Is(err, ErrStudentNotFound):
+		return EnrollmentStudentNotFound
+	case errors.Is(err, ErrAlreadyEnrolled):
+		return EnrollmentAlreadyEnrolled
+	case errors.Is(err, ErrAlreadyWaitlisted):
+		return EnrollmentAlreadyWaitlisted
+	case errors.Is(err, ErrBulkEnrollmentRolledBack):
+		return EnrollmentRolledBack
+	}
+	return ""
+}
+
+// ErrorDetails returns the structured details of err, nil when it has none
+func ErrorDetails(err error) interface{} {
+	var detailed interface{ Details() interface{} }
+	if !errors.As(err, &detailed) {
+		return nil
+	}
+	return detailed.Details()
+}
+
+// ScheduleConflictError lists the enrolled courses whose time overlaps the course being enrolled
+type ScheduleConflictError struct {
+	Courses []*CourseModel
+}
+
+func (_self *ScheduleConflictError) Error() string {
+	names := make([]string, 0, len(_self.Courses))
+	for _, course := range _self.Courses {
+		names = append(names, course.Name)
+	}
+	return "schedule conflicts with enrolled courses: " + strings.Join(names, ", ")
+}
+
+func (_self *ScheduleConflictError) Details() interface{} {
+	return _self.Courses
+}
+
+// MissingPrerequisitesError lists the prerequisites the student hasn't completed yet
+type MissingPrerequisitesError struct {
+	Courses []*CourseModel
+}
+
+func (_self *MissingPrerequisitesError) Error() string {
+	names := make([]string, 0, len(_self.Courses))
+	for _, course := range _self.Courses {
+		names = append(names, course.Name)
+	}
+	return "missing prerequisites: " + strings.Join(names, ", ")
+}
+
+func (_self *MissingPrerequisitesError) Details() interface{} {
+	return _self.Courses
+}
+
+// InvalidStatusTransitionError is returned when an enrollment can't move from its current status to the requested one
+type InvalidStatusTransitionError struct {
+	From    string
+	To      string
+	Allowed []string
+}
+
+func (_self *InvalidStatusTransitionError) Error() string {
+	message := "cannot change enrollment status from " + _self.From + " to " + _self.To
+	if len(_self.Allowed) == 0 {
+		return message + ", " + _self.From + " is a final status"
+	}
+	return message + ", allowed: " + strings.Join(_self.Allowed, ", ")
+}
+
+func (_self *InvalidStatusTransitionError) Details() interface{} {
+	return map[string]interface{}{
+		"from":    _self.From,
+		"to":      _self.To,
+		"allowed": _self.Allowed,
+	}
+}
+
+// RegistrationWindowError is returned when a student enrolls outside the course registration window
+type RegistrationWindowError struct {
+	Code     string
+	OpensAt  *string
+	ClosesAt *string
+}
+
+func (_self *RegistrationWindowError) Error() string {
+	if _self.Code == RegistrationNotOpen {
+		return "registration for this course opens at " + *_self.OpensAt
+	}
+	return "registration for this course closed at " + *_self.ClosesAt
+}
+
+func (_self *RegistrationWindowError) Details() interface{} {
+	return map[string]interface{}{
+		"code":     _self.Code,
+		"opensAt":  _self.OpensAt,
+		"closesAt": _self.ClosesAt,
+	}
+}
+
+// CreditLimitExceededError is returned when the course would take the student over the credit limit of the term
+type CreditLimitExceededError struct {
+	Term           string
+	CurrentCredits int
+	CourseCredits  int
+	Limit          int
+}
+
+func (_self *CreditLimitExceededError) Error() string {
+	return "enrolling would exceed the credit limit for " + _self.Term +
+		": current load " + strconv.Itoa(_self.CurrentCredits) +
+		", course credits " + strconv.Itoa(_self.CourseCredits) +
+		", limit " + strconv.Itoa(_self.Limit)
+}
+
+func (_self *CreditLimitExceededError) Details() interface{} {
+	return map[string]interface{}{
+		"term":           _self.Term,
+		"currentCredits": _self.CurrentCredits,
+		"courseCredits":  _self.CourseCredits,
+		"limit":          _self.Limit,
+	}
+}
+
+// CourseOutsideTermError is returned when the course dates don't fall inside the dates of its term
+type CourseOutsideTermError struct {
+	Term *TermModel
+}
+
+func (_self *CourseOutsideTermError) Error() string {
+	return "course must run between " + _self.Term.StartDate + " and " + _self.Term.EndDate +
+		", the dates of term " + _self.Term.Name
+}
+
+func (_self *CourseOutsideTermError) Details() interface{} {
+	return _self.Term
+}
+
+// StudentsNotEnrolledError lists the students on a roster who aren't enrolled in the course
+type StudentsNotEnrolledError struct {
+	StudentIDs []int
+}
+
+func (_self *StudentsNotEnrolledError) Error() string {
+	ids := make([]string, 0, len(_self.StudentIDs))
+	for _, id := range _self.StudentIDs {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return "students are not enrolled in this course: " + strings.Join(ids, ", ")
+}
+
+func (_self *StudentsNotEnrolledError) Details() interface{} {
+	return _self.StudentIDs
+}
+
+// TeacherScheduleConflictError lists the courses of the teacher that overlap the course being assigned to them
+type TeacherScheduleConflictError struct {
+	Courses []*CourseModel
+}
+
+func (_self *TeacherScheduleConflictError) Error() string {
+	names := make([]string, 0, len(_self.Courses))
+	for _, course := range _self.Courses {
+		names = append(names, course.Name)
+	}
+	return "teacher is already teaching at that time: " + strings.Join(names, ", ")
+}
+
+func (_self *TeacherScheduleConflictError) Details() interface{} {
+	return _self.Courses
+}
+
+// TeacherWorkloadExceededError is returned when the course would take the teacher over one of the workload limits of the term.
+// Current, Course and Limit count courses or weekly hours depending on the code.
+type TeacherWorkloadExceededError struct {
+	Code    string
+	Term    string
+	Current float64
+	Course  float64
+	Limit   float64
+}
+
+func (_self *TeacherWorkloadExceededError) Error() string {
+	limit := "course limit"
+	if _self.Code == TeacherWeeklyHoursLimit {
+		limit = "weekly hours limit"
+	}
+	return "assigning the course would exceed the teacher's " + limit + " for " + _self.Term +
+		": current " + strconv.FormatFloat(_self.Current, 'f', -1, 64) +
+		", course " + strconv.FormatFloat(_self.Course, 'f', -1, 64) +
+		", limit " + strconv.FormatFloat(_self.Limit, 'f', -1, 64)
+}
+
+func (_self *TeacherWorkloadExceededError) Details() interface{} {
+	return map[string]interface{}{
+		"code":    _self.Code,
+		"term":    _self.Term,
+		"current": _self.Current,
+		"course":  _self.Course,
+		"limit":   _self.Limit,
+	}
+}
+
+// TeacherHasCoursesError lists the courses that keep a teacher from being deleted
+type TeacherHasCoursesError struct {
+	Courses []*CourseModel
+}
+
+func (_self *TeacherHasCoursesError) Error() string {
+	names := make([]string, 0, len(_self.Courses))
+	for _, course := range _self.Courses {
+		names = append(names, course.Name)
+	}
+	return "teacher still teaches courses: " + strings.Join(names, ", ")
+}
+
+func (_self *TeacherHasCoursesError) Details() interface{} {
+	return _self.Courses
+}
+
+// RoomConflictError lists the courses that already booked the room at the time of the booking
+type RoomConflictError struct {
+	Courses []*CourseModel
+}
+
+func (_self *RoomConflictError) Error() string {
+	names := make([]string, 0, len(_self.Courses))
+	for _, course := range _self.Courses {
+		names = append(names, course.Name)
+	}
+	return "room is already booked at that time: " + strings.Join(names, ", ")
+}
+
+func (_self *RoomConflictError) Details() interface{} {
+	return _self.Courses
+}
+
+// RoomCapacityExceededError is returned when the course has more students enrolled than the room seats
+type RoomCapacityExceededError struct {
+	Capacity int
+	Enrolled int
+}
+
+func (_self *RoomCapacityExceededError) Error() string {
+	return "room seats " + strconv.Itoa(_self.Capacity) + " but the course has " + strconv.Itoa(_self.Enrolled) + " students enrolled"
+}
+
+func (_self *RoomCapacityExceededError) Details() interface{} {
+	return map[string]interface{}{
+		"capacity": _self.Capacity,
+		"enrolled": _self.Enrolled,
+	}
+}
+
+// RoomTooSmallError is returned when a room booked for the courses seats fewer students than their capacity
+type RoomTooSmallError struct {
+	Capacity int
+	Courses  []*CourseModel
+}
+
+func (_self *RoomTooSmallError) Error() string {
+	return "room seats " + strconv.Itoa(_self.Capacity) + ", fewer than the capacity of the course"
+}
+
+func (_self *RoomTooSmallError) Details() interface{} {
+	return map[string]interface{}{
+		"capacity": _self.Capacity,
+		"courses":  _self.Courses,
+	}
+}
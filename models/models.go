@@ -6,6 +6,8 @@ type StudentModel struct {
 	FirstName   string
 	LastName    string
 	DateOfBirth string
+
+	ProgramID *int `json:",omitempty"`
 }
 
 type UpdateStudentModel struct {
@@ -19,6 +21,33 @@ type TeacherModel struct {
 	FirstName   string
 	LastName    string
 	DateOfBirth string
+
+	DepartmentID *int `json:",omitempty"`
+}
+
+// TeacherFilterModel limits a teacher listing to one department
+type TeacherFilterModel struct {
+	DepartmentID string
+}
+
+const (
+	TermStatusPlanned = "planned"
+	TermStatusActive  = "active"
+	TermStatusClosed  = "closed"
+)
+
+var TermStatuses = []string{
+	TermStatusPlanned,
+	TermStatusActive,
+	TermStatusClosed,
+}
+
+type TermModel struct {
+	ID        int
+	Name      string
+	StartDate string
+	EndDate   string
+	Status    string
 }
 
 type CourseModel struct {
@@ -26,11 +55,338 @@ type CourseModel struct {
 	Name      string
 	StartTime string
 	EndTime   string
-	Teacher   *TeacherModel
+	Capacity  *int
+	Credits   int
+
+	RegistrationOpensAt  *string
+	RegistrationClosesAt *string
+
+	Term    *TermModel
+	Teacher *TeacherModel
+
+	DepartmentID *int `json:",omitempty"`
+
+	// Enrolled counts the students who take or took the course, only the course listings fill it in
+	Enrolled *int `json:",omitempty"`
+	// Teachers lists Teacher as lead followed by the other teachers of the course, only the v2 API fills it in
+	Teachers []*CourseTeacherModel `json:",omitempty"`
+}
+
+const (
+	CourseTeacherRoleLead      = "lead"
+	CourseTeacherRoleAssistant = "assistant"
+	CourseTeacherRoleGrader    = "grader"
+)
+
+// CourseTeacherRoles are ordered from the most to the least responsible role
+var CourseTeacherRoles = []string{CourseTeacherRoleLead, CourseTeacherRoleAssistant, CourseTeacherRoleGrader}
+
+// CourseTeacherModel is a teacher of a course with their role in it
+type CourseTeacherModel struct {
+	Teacher *TeacherModel
+	Role    string
+}
+
+var DaysOfWeek = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// MeetingPatternModel is the weekly schedule of a course, StartTime is the HH:MM wall clock time in TimeZone
+type MeetingPatternModel struct {
+	DaysOfWeek      []string
+	StartTime       string
+	DurationMinutes int
+	TimeZone        string
+	ExcludedDates   []string
+}
+
+// ScheduleEventModel is one entry of a calendar, a session of a course or the whole course when it has no sessions
+type ScheduleEventModel struct {
+	UID        string
+	CourseID   int
+	CourseName string
+	Teacher    string
+	StartTime  string
+	EndTime    string
 }
 
+// ScheduleFilterModel limits a schedule to the events overlapping the UTC days From to To, both are YYYY-MM-DD and optional
+type ScheduleFilterModel struct {
+	From string
+	To   string
+}
+
+// CourseFilterModel limits a course listing, TeacherID matches the primary teacher and the co-teachers,
+// From and To are YYYY-MM-DD days the courses must overlap and Status is CourseStatusActive or CourseStatusPast
+type CourseFilterModel struct {
+	TermID       string
+	TeacherID    string
+	DepartmentID string
+	From         string
+	To           string
+	Status       string
+}
+
+const (
+	// CourseStatusActive courses haven't ended yet
+	CourseStatusActive = "active"
+	// CourseStatusPast courses have ended
+	CourseStatusPast = "past"
+)
+
 type RegisterCourseModel struct {
 	Student *StudentModel
 	Course  *CourseModel
 
 }
+
+const (
+	EnrollmentStatusActive     = "active"
+	EnrollmentStatusWaitlisted = "waitlisted"
+	EnrollmentStatusWithdrawn  = "withdrawn"
+	EnrollmentStatusCompleted  = "completed"
+	EnrollmentStatusFailed     = "failed"
+)
+
+var EnrollmentStatuses = []string{
+	EnrollmentStatusActive,
+	EnrollmentStatusWaitlisted,
+	EnrollmentStatusWithdrawn,
+	EnrollmentStatusCompleted,
+	EnrollmentStatusFailed,
+}
+
+// EnrollmentStatusChangeModel is a requested transition of an enrollment to a new status
+type EnrollmentStatusChangeModel struct {
+	Status      string
+	Reason      string
+	PerformedBy string
+}
+
+// DefaultMaxCreditLoad is the most credits a student may carry in one term without an approved overload
+const DefaultMaxCreditLoad = 18
+
+const (
+	// TeacherDeletionRefuse deletes only a teacher without courses, it is the default
+	TeacherDeletionRefuse = "refuse"
+	// TeacherDeletionReassign moves the courses of the teacher to another teacher and deletes the teacher
+	TeacherDeletionReassign = "reassign"
+	// TeacherDeletionArchive keeps the teacher and their courses but no new course can be assigned to them
+	TeacherDeletionArchive = "archive"
+)
+
+var TeacherDeletionPolicies = []string{TeacherDeletionRefuse, TeacherDeletionReassign, TeacherDeletionArchive}
+
+// TeacherDeletionModel is how a teacher is removed, ReassignTo is the teacher taking over with TeacherDeletionReassign
+type TeacherDeletionModel struct {
+	Policy     string
+	ReassignTo string
+}
+
+// TeacherWorkloadModel limits what a teacher teaches in one term, a zero limit isn't enforced
+type TeacherWorkloadModel struct {
+	MaxWeeklyHours float64
+	MaxCourses     int
+}
+
+// DefaultTeacherWorkload is the most a teacher may teach in one term
+var DefaultTeacherWorkload = &TeacherWorkloadModel{
+	MaxWeeklyHours: 20,
+	MaxCourses:     5,
+}
+
+// CreditOverrideModel is an approved overload raising the student's credit limit per term
+type CreditOverrideModel struct {
+	MaxCredits int
+	ApprovedBy string
+}
+
+type StudentCourseFilterModel struct {
+	From   string
+	To     string
+	Status string
+}
+
+type WithdrawCourseModel struct {
+	Reason        string
+	EffectiveDate string
+	PerformedBy   string
+}
+
+const (
+	AttendanceStatusPresent = "present"
+	AttendanceStatusAbsent  = "absent"
+	AttendanceStatusLate    = "late"
+	AttendanceStatusExcused = "excused"
+)
+
+var AttendanceStatuses = []string{
+	AttendanceStatusPresent,
+	AttendanceStatusAbsent,
+	AttendanceStatusLate,
+	AttendanceStatusExcused,
+}
+
+// AttendanceRecordModel is the attendance of one student at a course session
+type AttendanceRecordModel struct {
+	StudentID int
+	Status    string
+	Note      string
+}
+
+// MarkRosterModel is the attendance of the course roster at the session held on SessionDate
+type MarkRosterModel struct {
+	SessionDate string
+	RecordedBy  string
+	Records     []*AttendanceRecordModel
+}
+
+const (
+	RosterSortByLastName  = "lastName"
+	RosterSortByFirstName = "firstName"
+	RosterSortByStudentID = "studentID"
+)
+
+type RosterFilterModel struct {
+	Limit  int
+	Offset int
+	SortBy string
+	Order  string
+}
+
+// EnrollmentOptionsModel carries the checks a privileged caller chose to skip when enrolling a student,
+// OverriddenBy is who skipped them and is kept in the status history of the enrollment
+type EnrollmentOptionsModel struct {
+	OverrideScheduleConflict bool
+	OverriddenBy             string
+}
+
+const (
+	BulkEnrollmentAllOrNothing = "all-or-nothing"
+	BulkEnrollmentBestEffort   = "best-effort"
+)
+
+type BulkEnrollmentModel struct {
+	StudentIDs   []int
+	StudentCodes []string
+	Mode         string
+	Options      *EnrollmentOptionsModel
+}
+
+type RecordGradeModel struct {
+	Letter *string
+	Score  *float64
+}
+
+// GradeModel is the grade of one enrollment together with the course it was given for
+type GradeModel struct {
+	EnrollmentID int
+	CourseID     int
+	CourseName   string
+	Credits      int
+	Term         string
+	StartTime    string
+	Letter       *string
+	Score        *float64
+}
+
+// GradeScaleModel maps grades to grade points. Letter grades are looked up directly,
+// numeric grades get the points of the first threshold they reach, so Thresholds is ordered by MinScore descending.
+type GradeScaleModel struct {
+	Letters    map[string]float64
+	Thresholds []*GradeThresholdModel
+}
+
+type GradeThresholdModel struct {
+	MinScore float64
+	Points   float64
+}
+
+var DefaultGradeScale = &GradeScaleModel{
+	Letters: map[string]float64{
+		"A": 4.0, "A-": 3.7,
+		"B+": 3.3, "B": 3.0, "B-": 2.7,
+		"C+": 2.3, "C": 2.0, "C-": 1.7,
+		"D+": 1.3, "D": 1.0,
+		"F": 0,
+	},
+	Thresholds: []*GradeThresholdModel{
+		{MinScore: 93, Points: 4.0},
+		{MinScore: 90, Points: 3.7},
+		{MinScore: 87, Points: 3.3},
+		{MinScore: 83, Points: 3.0},
+		{MinScore: 80, Points: 2.7},
+		{MinScore: 77, Points: 2.3},
+		{MinScore: 73, Points: 2.0},
+		{MinScore: 70, Points: 1.7},
+		{MinScore: 67, Points: 1.3},
+		{MinScore: 60, Points: 1.0},
+		{MinScore: 0, Points: 0},
+	},
+}
+
+type TermGPAModel struct {
+	Term    string
+	GPA     float64
+	Courses int
+}
+
+// GPAModel holds the cumulative GPA and the GPA of every term, Cumulative is nil until a course with credits is graded
+type GPAModel struct {
+	Cumulative *float64
+	Courses    int
+	Terms      []*TermGPAModel
+}
+
+type RoomModel struct {
+	Building string
+	Number   string
+	Capacity int
+	Features []string
+}
+
+// RoomFilterModel looks for the rooms free between From and To with at least MinCapacity seats and all of Features
+type RoomFilterModel struct {
+	From        string
+	To          string
+	MinCapacity int
+	Features    []string
+}
+
+type DepartmentModel struct {
+	Code string
+	Name string
+}
+
+type ProgramModel struct {
+	DepartmentID int
+	Code         string
+	Name         string
+	Degree       string
+}
+
+// ProgramFilterModel limits a program listing to one department
+type ProgramFilterModel struct {
+	DepartmentID string
+}
+
+const (
+	RequirementKindCourses = "courses"
+	RequirementKindCredits = "credits"
+)
+
+var RequirementKinds = []string{RequirementKindCourses, RequirementKindCredits}
+
+// ProgramRequirementModel is a rule of a program. A courses requirement needs every course of CourseIDs,
+// a credits requirement needs Credits credits from them. With MinGrade a course only counts when its grade reaches it.
+type ProgramRequirementModel struct {
+	Name      string
+	Kind      string
+	Credits   *int
+	MinGrade  *string
+	CourseIDs []int
+}
+
+const (
+	AuditStatusSatisfied  = "satisfied"
+	AuditStatusInProgress = "in-progress"
+	AuditStatusMissing    = "missing"
+)
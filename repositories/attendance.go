@@ -0,0 +1,192 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"student_rest/models"
+)
+
+type Attendance struct {
+	Db *sql.DB
+}
+
+type AttendanceRepositories interface {
+	MarkRoster(courseID int, roster *models.MarkRosterModel) ([]*AttendanceEntity, error)
+	GetCourseAttendance(courseID int) (*CourseAttendanceEntity, error)
+	GetStudentAttendance(studentID string) (*StudentAttendanceSummaryEntity, error)
+}
+
+// attendanceCounts counts the attendance joined as a by status, its arguments start at $2
+const attendanceCounts = `COUNT(a.id),
+	COUNT(a.id) FILTER (WHERE a.status = $2),
+	COUNT(a.id) FILTER (WHERE a.status = $3),
+	COUNT(a.id) FILTER (WHERE a.status = $4),
+	COUNT(a.id) FILTER (WHERE a.status = $5)`
+
+// Attendance is kept for the students who take or took the course
+var attendanceEnrollmentStatuses = []interface{}{models.EnrollmentStatusActive, models.EnrollmentStatusCompleted}
+
+// MarkRoster records the attendance of the listed students at one session in one transaction.
+// Marking a session again overwrites the earlier attendance of those students.
+func (_self Attendance) MarkRoster(courseID int, roster *models.MarkRosterModel) ([]*AttendanceEntity, error) {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	sqlStmt := `SELECT id FROM students_courses WHERE course_id=$1 AND student_id=$2 AND status IN ($3, $4)
+		ORDER BY id DESC LIMIT 1`
+	enrollmentIDs := make([]int, len(roster.Records))
+	notEnrolled := []int{}
+	for i, record := range roster.Records {
+		err = tx.QueryRowContext(ctx, sqlStmt, courseID, record.StudentID,
+			models.EnrollmentStatusActive, models.EnrollmentStatusCompleted).Scan(&enrollmentIDs[i])
+		if err == sql.ErrNoRows {
+			notEnrolled = append(notEnrolled, record.StudentID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(notEnrolled) > 0 {
+		return nil, &models.StudentsNotEnrolledError{StudentIDs: notEnrolled}
+	}
+
+	sqlStmt = `INSERT INTO attendance(enrollment_id, session_date, status, note, recorded_by) VALUES ($1, $2, $3, NULLIF($4, ''), $5)
+		ON CONFLICT (enrollment_id, session_date) DO UPDATE
+		SET status = EXCLUDED.status, note = EXCLUDED.note, recorded_by = EXCLUDED.recorded_by, recorded_at = now()
+		RETURNING id, session_date, status, note, recorded_by, recorded_at`
+	attendance := make([]*AttendanceEntity, 0, len(roster.Records))
+	for i, record := range roster.Records {
+		entity := AttendanceEntity{
+			EnrollmentID: enrollmentIDs[i],
+			StudentID:    record.StudentID,
+		}
+		err = tx.QueryRowContext(ctx, sqlStmt, enrollmentIDs[i], roster.SessionDate, record.Status, record.Note, roster.RecordedBy).
+			Scan(&entity.ID, &entity.SessionDate, &entity.Status, &entity.Note, &entity.RecordedBy, &entity.RecordedAt)
+		if err != nil {
+			return nil, err
+		}
+		attendance = append(attendance, &entity)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return attendance, nil
+}
+
+// GetCourseAttendance counts the attendance of every student who takes or took the course, ordered by name
+func (_self Attendance) GetCourseAttendance(courseID int) (*CourseAttendanceEntity, error) {
+	course := CourseAttendanceEntity{
+		CourseID: courseID,
+		Students: []*StudentAttendanceEntity{},
+	}
+
+	sqlStmt := `SELECT COUNT(DISTINCT a.session_date)
+		FROM attendance a
+		JOIN students_courses sc ON sc.id = a.enrollment_id
+		WHERE sc.course_id = $1`
+	err := _self.Db.QueryRow(sqlStmt, courseID).Scan(&course.SessionsHeld)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `SELECT s.id, s.student_id, s.first_name, s.last_name, s.date_of_birth, ` + attendanceCounts + `
+		FROM students_courses sc
+		JOIN students s ON s.id = sc.student_id
+		LEFT JOIN attendance a ON a.enrollment_id = sc.id
+		WHERE sc.course_id = $1 AND sc.status IN ($6, $7)
+		GROUP BY s.id
+		ORDER BY s.last_name, s.first_name, s.id`
+	args := append([]interface{}{courseID}, attendanceStatusArgs()...)
+	rows, err := _self.Db.Query(sqlStmt, append(args, attendanceEnrollmentStatuses...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		student := StudentAttendanceEntity{
+			Student:    &StudentEntity{},
+			Attendance: &AttendanceSummaryEntity{},
+		}
+		err = rows.Scan(&student.Student.ID, &student.Student.StudentID, &student.Student.FirstName,
+			&student.Student.LastName, &student.Student.DateOfBirth,
+			&student.Attendance.Sessions, &student.Attendance.Present, &student.Attendance.Absent,
+			&student.Attendance.Late, &student.Attendance.Excused)
+		if err != nil {
+			return nil, err
+		}
+		course.Students = append(course.Students, &student)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return &course, nil
+}
+
+// GetStudentAttendance counts the attendance of the student in every course they take or took, ordered by start time
+func (_self Attendance) GetStudentAttendance(studentID string) (*StudentAttendanceSummaryEntity, error) {
+	sqlStmt := `SELECT id, student_id, first_name, last_name, date_of_birth FROM students WHERE id=$1`
+	var student StudentEntity
+	err := _self.Db.QueryRow(sqlStmt, studentID).Scan(&student.ID, &student.StudentID, &student.FirstName,
+		&student.LastName, &student.DateOfBirth)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `SELECT c.id, c.name, ` + attendanceCounts + `
+		FROM students_courses sc
+		JOIN courses c ON c.id = sc.course_id
+		LEFT JOIN attendance a ON a.enrollment_id = sc.id
+		WHERE sc.student_id = $1 AND sc.status IN ($6, $7)
+		GROUP BY c.id
+		ORDER BY c.start_time, c.id`
+	args := append([]interface{}{student.ID}, attendanceStatusArgs()...)
+	rows, err := _self.Db.Query(sqlStmt, append(args, attendanceEnrollmentStatuses...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	summary := StudentAttendanceSummaryEntity{
+		Student: &student,
+		Courses: []*CourseAttendanceSummaryEntity{},
+	}
+	for rows.Next() {
+		course := CourseAttendanceSummaryEntity{
+			Attendance: &AttendanceSummaryEntity{},
+		}
+		err = rows.Scan(&course.CourseID, &course.CourseName,
+			&course.Attendance.Sessions, &course.Attendance.Present, &course.Attendance.Absent,
+			&course.Attendance.Late, &course.Attendance.Excused)
+		if err != nil {
+			return nil, err
+		}
+		summary.Courses = append(summary.Courses, &course)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// attendanceStatusArgs are the arguments of attendanceCounts
+func attendanceStatusArgs() []interface{} {
+	return []interface{}{
+		models.AttendanceStatusPresent,
+		models.AttendanceStatusAbsent,
+		models.AttendanceStatusLate,
+		models.AttendanceStatusExcused,
+	}
+}
@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/testhelpers"
+	"student_rest/utils"
+	"testing"
+)
+
+func Test_MarkRoster(t *testing.T) {
+	note := "sick"
+
+	testCases := []struct {
+		name          string
+		input         *models.MarkRosterModel
+		expectedValue []*AttendanceEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name: "student not enrolled",
+			input: &models.MarkRosterModel{
+				SessionDate: "2020-11-16",
+				RecordedBy:  "Anh Le",
+				Records: []*models.AttendanceRecordModel{
+					{StudentID: 1, Status: models.AttendanceStatusPresent},
+					{StudentID: 3, Status: models.AttendanceStatusPresent},
+				},
+			},
+			expectedValue: nil,
+			expectedError: &models.StudentsNotEnrolledError{StudentIDs: []int{3}},
+			giveFixture:   "./testdata/attendance/attendance.sql",
+		},
+		{
+			name: "mark roster again overwrites the session",
+			input: &models.MarkRosterModel{
+				SessionDate: "2020-11-02",
+				RecordedBy:  "Duyen Nguyen",
+				Records: []*models.AttendanceRecordModel{
+					{StudentID: 2, Status: models.AttendanceStatusExcused, Note: "sick"},
+				},
+			},
+			expectedValue: []*AttendanceEntity{
+				{
+					ID:           2,
+					EnrollmentID: 2,
+					StudentID:    2,
+					SessionDate:  "2020-11-02T00:00:00Z",
+					Status:       models.AttendanceStatusExcused,
+					Note:         &note,
+					RecordedBy:   "Duyen Nguyen",
+				},
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/attendance/attendance.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			attendanceRepo := Attendance{
+				Db: dbMock,
+			}
+
+			result, err := attendanceRepo.MarkRoster(1, testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Len(t, result, len(testCase.expectedValue))
+				for i, expected := range testCase.expectedValue {
+					require.Equal(t, expected.ID, result[i].ID)
+					require.Equal(t, expected.EnrollmentID, result[i].EnrollmentID)
+					require.Equal(t, expected.SessionDate, result[i].SessionDate)
+					require.Equal(t, expected.Status, result[i].Status)
+					require.Equal(t, expected.Note, result[i].Note)
+					require.Equal(t, expected.RecordedBy, result[i].RecordedBy)
+				}
+			}
+		})
+	}
+}
+
+func Test_GetCourseAttendance(t *testing.T) {
+	dbMock, _ := testhelpers.ConnectDB()
+
+	utils.LoadFixture(dbMock, "./testdata/attendance/attendance.sql")
+
+	attendanceRepo := Attendance{
+		Db: dbMock,
+	}
+
+	result, err := attendanceRepo.GetCourseAttendance(1)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, result.SessionsHeld)
+	require.Len(t, result.Students, 2)
+	require.Equal(t, "Dao", result.Students[0].Student.LastName)
+	require.Equal(t, &AttendanceSummaryEntity{Sessions: 1, Absent: 1}, result.Students[0].Attendance)
+	require.Equal(t, "Le", result.Students[1].Student.LastName)
+	require.Equal(t, &AttendanceSummaryEntity{Sessions: 2, Present: 1, Late: 1}, result.Students[1].Attendance)
+}
@@ -1,7 +1,12 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+	"github.com/lib/pq"
+	"sort"
+	"strings"
 	"student_rest/models"
 )
 
@@ -13,21 +18,51 @@ type Course struct{
 type CourseRepositories interface {
 	CreateCourse(course *CourseEntity) (*models.CourseModel, error)
 	GetCourseByID(id string) (*models.CourseModel, error)
+	GetCourses(filter *models.CourseFilterModel) ([]*models.CourseModel, error)
 	DeleteCourse(id string) error
-	UpdateCourse(id string, course *CourseEntity) error
+	UpdateCourse(id string, course *CourseEntity, sessions []*SessionEntity, maxCreditLoad int) error
+	GetCourseStudents(id string, filter *models.RosterFilterModel) ([]*StudentEntity, int, error)
+	GetPrerequisites(id string) ([]*models.CourseModel, error)
+	AddPrerequisite(id string, prerequisiteID string) error
+	DeletePrerequisite(id string, prerequisiteID string) error
+	BulkEnrollCourse(id string, studentIDs []int, mode string, options *models.EnrollmentOptionsModel, maxCreditLoad int) ([]*BulkEnrollmentResultEntity, error)
+	SetRegistrationOverride(id string, studentID string, grantedBy string) error
+	DeleteRegistrationOverride(id string, studentID string) error
+	HasRegistrationOverride(id string, studentID string) (bool, error)
+	SetMeetingPattern(pattern *MeetingPatternEntity, sessions []*SessionEntity) ([]*SessionEntity, error)
+	GetMeetingPattern(id string) (*MeetingPatternEntity, error)
+	DeleteMeetingPattern(id string) error
+	GetCourseSessions(id string) ([]*SessionEntity, error)
+	GetEnrolledCount(id string) (int, error)
+	GetCourseTeachers(courseIDs []int) (map[int][]*models.CourseTeacherModel, error)
+	SetCourseTeacher(courseID int, teacherID int, role string) error
+	DeleteCourseTeacher(courseID int, teacherID string) error
+}
+
+var rosterSortColumns = map[string]string{
+	models.RosterSortByLastName:  "s.last_name",
+	models.RosterSortByFirstName: "s.first_name",
+	models.RosterSortByStudentID: "s.student_id",
 }
 
 func (_self Course) CreateCourse(course *CourseEntity) (*models.CourseModel, error) {
-	sqlStmt := `INSERT INTO courses("name", "start_time", "end_time", "teacher_id") VALUES ($1, $2, $3, $4) RETURNING id`
+	sqlStmt := `INSERT INTO courses("name", "start_time", "end_time", "capacity", "credits", "registration_opens_at", "registration_closes_at", "term_id", "teacher_id", "department_id")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`
 	id := 0
-	err := _self.Db.QueryRow(sqlStmt, course.Name, course.StartTime, course.EndTime, course.TeacherID).Scan(&id)
+	err := _self.Db.QueryRow(sqlStmt, course.Name, course.StartTime, course.EndTime, course.Capacity, course.Credits,
+		course.RegistrationOpensAt, course.RegistrationClosesAt, course.TermID, course.TeacherID, course.DepartmentID).Scan(&id)
 
 	if err != nil {
 		return nil, err
 	}
 	course.ID = id
 
-	sqlStmt = `SELECT * FROM teachers WHERE id=$1`
+	term, err := getTerm(_self.Db, course.TermID)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `SELECT id, first_name, last_name, date_of_birth FROM teachers WHERE id=$1`
 	var teacher models.TeacherModel
 	err = _self.Db.QueryRow(sqlStmt, course.TeacherID).Scan(&teacher.ID, &teacher.FirstName, &teacher.LastName, &teacher.DateOfBirth)
 
@@ -40,20 +75,37 @@ func (_self Course) CreateCourse(course *CourseEntity) (*models.CourseModel, err
 		Name:      course.Name,
 		StartTime: course.StartTime,
 		EndTime:   course.EndTime,
-		Teacher:   &teacher,
+		Capacity:  course.Capacity,
+		Credits:   course.Credits,
+
+		RegistrationOpensAt:  course.RegistrationOpensAt,
+		RegistrationClosesAt: course.RegistrationClosesAt,
+
+		Term:    term,
+		Teacher: &teacher,
+
+		DepartmentID: course.DepartmentID,
 	}
 	return newCourse, nil
 }
 
 func (_self Course) GetCourseByID(id string) (*models.CourseModel, error) {
-	sqlStmt := `SELECT * FROM courses WHERE id = $1`
+	sqlStmt := `SELECT id, name, start_time, end_time, capacity, credits, registration_opens_at, registration_closes_at, term_id, teacher_id,
+			department_id
+		FROM courses WHERE id = $1`
 	var course CourseEntity
-	err := _self.Db.QueryRow(sqlStmt, id).Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime, &course.TeacherID)
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime, &course.Capacity, &course.Credits,
+		&course.RegistrationOpensAt, &course.RegistrationClosesAt, &course.TermID, &course.TeacherID, &course.DepartmentID)
 	if err != nil {
 		return nil, err
 	}
 
-	sqlStmt = `SELECT * FROM teachers WHERE id=$1`
+	term, err := getTerm(_self.Db, course.TermID)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `SELECT id, first_name, last_name, date_of_birth FROM teachers WHERE id=$1`
 	var teacher models.TeacherModel
 	err = _self.Db.QueryRow(sqlStmt, course.TeacherID).Scan(&teacher.ID, &teacher.FirstName, &teacher.LastName, &teacher.DateOfBirth)
 	if err != nil {
@@ -64,18 +116,657 @@ func (_self Course) GetCourseByID(id string) (*models.CourseModel, error) {
 		Name:      course.Name,
 		StartTime: course.StartTime,
 		EndTime:   course.EndTime,
-		Teacher:   &teacher,
+		Capacity:  course.Capacity,
+		Credits:   course.Credits,
+
+		RegistrationOpensAt:  course.RegistrationOpensAt,
+		RegistrationClosesAt: course.RegistrationClosesAt,
+
+		Term:    term,
+		Teacher: &teacher,
+
+		DepartmentID: course.DepartmentID,
 	}, nil
 }
 
+// GetCourses lists the courses ordered by start time with their enrollment counts, narrowed down by the filter
+func (_self Course) GetCourses(filter *models.CourseFilterModel) ([]*models.CourseModel, error) {
+	sqlStmt := `SELECT c.id, c.name, c.start_time, c.end_time, c.capacity, c.credits, c.registration_opens_at, c.registration_closes_at,
+			tm.id, tm.name, tm.start_date, tm.end_date, tm.status, t.id, t.first_name, t.last_name, t.date_of_birth,
+			(SELECT COUNT(*) FROM students_courses sc WHERE sc.course_id = c.id AND sc.status IN ($1, $2)), c.department_id
+		FROM courses c
+		JOIN terms tm ON tm.id = c.term_id
+		JOIN teachers t ON t.id = c.teacher_id`
+	args := []interface{}{models.EnrollmentStatusActive, models.EnrollmentStatusCompleted}
+	conditions := []string{}
+	if filter.TermID != "" {
+		termID := 0
+		err := _self.Db.QueryRow(`SELECT id FROM terms WHERE id=$1`, filter.TermID).Scan(&termID)
+		if err == sql.ErrNoRows {
+			return nil, models.ErrTermNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, termID)
+		conditions = append(conditions, fmt.Sprintf(`c.term_id = $%d`, len(args)))
+	}
+	if filter.TeacherID != "" {
+		teacherID := 0
+		err := _self.Db.QueryRow(`SELECT id FROM teachers WHERE id=$1`, filter.TeacherID).Scan(&teacherID)
+		if err == sql.ErrNoRows {
+			return nil, models.ErrTeacherNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// The teacher also teaches the courses they co-teach
+		args = append(args, teacherID)
+		conditions = append(conditions, fmt.Sprintf(`(c.teacher_id = $%[1]d OR EXISTS (
+			SELECT 1 FROM course_teachers ct WHERE ct.course_id = c.id AND ct.teacher_id = $%[1]d))`, len(args)))
+	}
+	if filter.DepartmentID != "" {
+		departmentID, err := findDepartment(_self.Db, filter.DepartmentID)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, departmentID)
+		conditions = append(conditions, fmt.Sprintf(`c.department_id = $%d`, len(args)))
+	}
+	if filter.From != "" {
+		args = append(args, filter.From)
+		conditions = append(conditions, fmt.Sprintf(`c.end_time > $%d::date`, len(args)))
+	}
+	if filter.To != "" {
+		// The last day is included
+		args = append(args, filter.To)
+		conditions = append(conditions, fmt.Sprintf(`c.start_time < $%d::date + 1`, len(args)))
+	}
+	switch filter.Status {
+	case models.CourseStatusActive:
+		conditions = append(conditions, `c.end_time > now()`)
+	case models.CourseStatusPast:
+		conditions = append(conditions, `c.end_time <= now()`)
+	}
+	if len(conditions) > 0 {
+		sqlStmt += ` WHERE ` + strings.Join(conditions, ` AND `)
+	}
+	sqlStmt += ` ORDER BY c.start_time, c.id`
+
+	rows, err := _self.Db.Query(sqlStmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	courses := []*models.CourseModel{}
+	for rows.Next() {
+		course := models.CourseModel{Term: &models.TermModel{}, Teacher: &models.TeacherModel{}, Enrolled: new(int)}
+		err = rows.Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime, &course.Capacity, &course.Credits,
+			&course.RegistrationOpensAt, &course.RegistrationClosesAt,
+			&course.Term.ID, &course.Term.Name, &course.Term.StartDate, &course.Term.EndDate, &course.Term.Status,
+			&course.Teacher.ID, &course.Teacher.FirstName, &course.Teacher.LastName, &course.Teacher.DateOfBirth,
+			course.Enrolled, &course.DepartmentID)
+		if err != nil {
+			return nil, err
+		}
+		courses = append(courses, &course)
+	}
+	return courses, rows.Err()
+}
+
 func (_self Course) DeleteCourse(id string) error {
 	sqlStmt := `DELETE FROM courses WHERE id=$1`
 	_, err := _self.Db.Exec(sqlStmt, id)
 	return err
 }
 
-func (_self Course) UpdateCourse(id string, course *CourseEntity) error {
-	sqlStmt := `UPDATE courses SET "name" = $2, "start_time" = $3, "end_time" = $4, "teacher_id" = $5 WHERE id=$1`
-	_, err := _self.Db.Exec(sqlStmt, id, course.Name, course.StartTime, course.EndTime, course.TeacherID)
+// UpdateCourse updates the course, seats added by a bigger capacity go to the waitlisted students within maxCreditLoad.
+// The sessions of a course with a meeting pattern are replaced with the given ones in the same transaction, nil
+// sessions leave them as they are.
+func (_self Course) UpdateCourse(id string, course *CourseEntity, sessions []*SessionEntity, maxCreditLoad int) error {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	sqlStmt := `UPDATE courses SET "name" = $2, "start_time" = $3, "end_time" = $4, "capacity" = $5, "credits" = $6,
+		"registration_opens_at" = $7, "registration_closes_at" = $8, "term_id" = $9, "teacher_id" = $10, "department_id" = $11
+		WHERE id=$1 RETURNING id`
+	courseID := 0
+	err = tx.QueryRowContext(ctx, sqlStmt, id, course.Name, course.StartTime, course.EndTime, course.Capacity, course.Credits,
+		course.RegistrationOpensAt, course.RegistrationClosesAt, course.TermID, course.TeacherID, course.DepartmentID).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return models.ErrCourseNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	// The primary teacher isn't listed again among the other teachers of the course
+	sqlStmt = `DELETE FROM course_teachers WHERE course_id=$1 AND teacher_id=$2`
+	if _, err = tx.ExecContext(ctx, sqlStmt, courseID, course.TeacherID); err != nil {
+		return err
+	}
+
+	if sessions != nil {
+		if _, err = replaceSessions(ctx, tx, courseID, sessions); err != nil {
+			return err
+		}
+	}
+
+	if err = promoteWaitlisted(ctx, tx, courseID, maxCreditLoad); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetCourseStudents returns a page of the students actively enrolled in the course and the total number of them
+func (_self Course) GetCourseStudents(id string, filter *models.RosterFilterModel) ([]*StudentEntity, int, error) {
+	sqlStmt := `SELECT id FROM courses WHERE id=$1`
+	courseID := 0
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return nil, 0, models.ErrCourseNotFound
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sqlStmt = `SELECT COUNT(*) FROM students_courses WHERE course_id=$1 AND status=$2`
+	total := 0
+	err = _self.Db.QueryRow(sqlStmt, courseID, models.EnrollmentStatusActive).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sortColumn, ok := rosterSortColumns[filter.SortBy]
+	if !ok {
+		sortColumn = rosterSortColumns[models.RosterSortByLastName]
+	}
+	order := "ASC"
+	if filter.Order == "desc" {
+		order = "DESC"
+	}
+
+	sqlStmt = fmt.Sprintf(`SELECT s.id, s.student_id, s.first_name, s.last_name, s.date_of_birth
+		FROM students_courses sc
+		JOIN students s ON s.id = sc.student_id
+		WHERE sc.course_id = $1 AND sc.status = $2
+		ORDER BY %s %s, s.id %s
+		LIMIT $3 OFFSET $4`, sortColumn, order, order)
+	rows, err := _self.Db.Query(sqlStmt, courseID, models.EnrollmentStatusActive, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	students := []*StudentEntity{}
+	for rows.Next() {
+		var student StudentEntity
+		err = rows.Scan(&student.ID, &student.StudentID, &student.FirstName, &student.LastName, &student.DateOfBirth)
+		if err != nil {
+			return nil, 0, err
+		}
+		students = append(students, &student)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return students, total, nil
+}
+
+func (_self Course) GetPrerequisites(id string) ([]*models.CourseModel, error) {
+	sqlStmt := `SELECT id FROM courses WHERE id=$1`
+	courseID := 0
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrCourseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `SELECT c.id, c.name, c.start_time, c.end_time, c.capacity, t.id, t.first_name, t.last_name, t.date_of_birth
+		FROM course_prerequisites cp
+		JOIN courses c ON c.id = cp.prerequisite_id
+		JOIN teachers t ON t.id = c.teacher_id
+		WHERE cp.course_id = $1
+		ORDER BY c.id`
+	rows, err := _self.Db.Query(sqlStmt, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prerequisites := []*models.CourseModel{}
+	for rows.Next() {
+		course := models.CourseModel{Teacher: &models.TeacherModel{}}
+		err = rows.Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime, &course.Capacity,
+			&course.Teacher.ID, &course.Teacher.FirstName, &course.Teacher.LastName, &course.Teacher.DateOfBirth)
+		if err != nil {
+			return nil, err
+		}
+		prerequisites = append(prerequisites, &course)
+	}
+	return prerequisites, rows.Err()
+}
+
+// AddPrerequisite makes prerequisiteID a prerequisite of the course, unless the prerequisite already requires the course.
+// The cycle check and the insert run in one transaction so concurrent inserts can't close a cycle.
+func (_self Course) AddPrerequisite(id string, prerequisiteID string) error {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	// Both course rows are locked in id order like every other path locking courses, then the prerequisite table is locked
+	// against other writers because inserts on disjoint course pairs can still close a longer cycle together
+	sqlStmt := `SELECT id FROM courses WHERE id IN ($1, $2) ORDER BY id FOR UPDATE`
+	rows, err := tx.QueryContext(ctx, sqlStmt, id, prerequisiteID)
+	if err != nil {
+		return err
+	}
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	if count != 2 {
+		return models.ErrCourseNotFound
+	}
+
+	if _, err = tx.ExecContext(ctx, `LOCK TABLE course_prerequisites IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return err
+	}
+
+	createsCycle := false
+	sqlStmt = `WITH RECURSIVE required(id) AS (
+			SELECT $1::int
+			UNION
+			SELECT cp.prerequisite_id FROM course_prerequisites cp JOIN required r ON cp.course_id = r.id
+		)
+		SELECT EXISTS(SELECT 1 FROM required WHERE id = $2::int)`
+	if err = tx.QueryRowContext(ctx, sqlStmt, prerequisiteID, id).Scan(&createsCycle); err != nil {
+		return err
+	}
+	if createsCycle {
+		return models.ErrPrerequisiteCycle
+	}
+
+	sqlStmt = `INSERT INTO course_prerequisites(course_id, prerequisite_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	result, err := tx.ExecContext(ctx, sqlStmt, id, prerequisiteID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return models.ErrPrerequisiteExists
+	}
+	return tx.Commit()
+}
+
+func (_self Course) DeletePrerequisite(id string, prerequisiteID string) error {
+	sqlStmt := `DELETE FROM course_prerequisites WHERE course_id=$1 AND prerequisite_id=$2`
+	result, err := _self.Db.Exec(sqlStmt, id, prerequisiteID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return models.ErrPrerequisiteNotFound
+	}
+	return nil
+}
+
+// Fail records why the student of a bulk enrollment wasn't enrolled
+func (_self *BulkEnrollmentResultEntity) Fail(err error) {
+	_self.Success = false
+	_self.Enrollment = nil
+	_self.Error = err.Error()
+	_self.Code = models.EnrollmentErrorCode(err)
+	_self.Details = models.ErrorDetails(err)
+}
+
+// BulkEnrollCourse enrolls the students in one transaction and returns a result per student in the given order.
+// In all-or-nothing mode nothing is written when any student fails.
+func (_self Course) BulkEnrollCourse(id string, studentIDs []int, mode string, options *models.EnrollmentOptionsModel,
+	maxCreditLoad int) ([]*BulkEnrollmentResultEntity, error) {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	course, err := lockCourse(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*BulkEnrollmentResultEntity, len(studentIDs))
+	for i, studentID := range studentIDs {
+		results[i] = &BulkEnrollmentResultEntity{StudentID: studentID}
+	}
+
+	// Lock the students in id order so concurrent bulk enrollments can't deadlock
+	order := make([]int, len(studentIDs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return studentIDs[order[a]] < studentIDs[order[b]]
+	})
+
+	failed := false
+	for _, i := range order {
+		result := results[i]
+
+		// A failed statement aborts the whole transaction, the savepoint keeps the other students' enrollments
+		if _, err = tx.ExecContext(ctx, `SAVEPOINT bulk_enrollment`); err != nil {
+			return nil, err
+		}
+
+		enrollment, err := enrollStudent(ctx, tx, result.StudentID, course, options, maxCreditLoad)
+		if err != nil {
+			if _, rollbackErr := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT bulk_enrollment`); rollbackErr != nil {
+				return nil, rollbackErr
+			}
+			result.Fail(err)
+			failed = true
+			continue
+		}
+
+		if _, err = tx.ExecContext(ctx, `RELEASE SAVEPOINT bulk_enrollment`); err != nil {
+			return nil, err
+		}
+		result.Success = true
+		result.Enrollment = enrollment
+	}
+
+	if failed && mode == models.BulkEnrollmentAllOrNothing {
+		for _, result := range results {
+			if result.Success {
+				result.Fail(models.ErrBulkEnrollmentRolledBack)
+			}
+		}
+		return results, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// SetRegistrationOverride lets the student enroll in the course outside its registration window
+func (_self Course) SetRegistrationOverride(id string, studentID string, grantedBy string) error {
+	sqlStmt := `SELECT id FROM courses WHERE id=$1`
+	courseID := 0
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return models.ErrCourseNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	sqlStmt = `SELECT id FROM students WHERE id=$1`
+	student := 0
+	err = _self.Db.QueryRow(sqlStmt, studentID).Scan(&student)
+	if err == sql.ErrNoRows {
+		return models.ErrStudentNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	sqlStmt = `INSERT INTO course_registration_overrides(course_id, student_id, granted_by) VALUES ($1, $2, $3)
+		ON CONFLICT (course_id, student_id) DO UPDATE SET granted_by = EXCLUDED.granted_by, granted_at = now()`
+	_, err = _self.Db.Exec(sqlStmt, courseID, student, grantedBy)
+	return err
+}
+
+func (_self Course) DeleteRegistrationOverride(id string, studentID string) error {
+	sqlStmt := `DELETE FROM course_registration_overrides WHERE course_id=$1 AND student_id=$2`
+	result, err := _self.Db.Exec(sqlStmt, id, studentID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrRegistrationOverrideNotFound
+	}
+	return nil
+}
+
+func (_self Course) HasRegistrationOverride(id string, studentID string) (bool, error) {
+	sqlStmt := `SELECT EXISTS(SELECT 1 FROM course_registration_overrides WHERE course_id=$1 AND student_id=$2)`
+	exists := false
+	err := _self.Db.QueryRow(sqlStmt, id, studentID).Scan(&exists)
+	return exists, err
+}
+
+// SetMeetingPattern saves the meeting pattern of the course and replaces its sessions with the given ones
+func (_self Course) SetMeetingPattern(pattern *MeetingPatternEntity, sessions []*SessionEntity) ([]*SessionEntity, error) {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	sqlStmt := `INSERT INTO course_meeting_patterns(course_id, days_of_week, start_time, duration_minutes, time_zone, excluded_dates)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (course_id) DO UPDATE SET days_of_week = EXCLUDED.days_of_week, start_time = EXCLUDED.start_time,
+			duration_minutes = EXCLUDED.duration_minutes, time_zone = EXCLUDED.time_zone, excluded_dates = EXCLUDED.excluded_dates`
+	_, err = tx.ExecContext(ctx, sqlStmt, pattern.CourseID, pq.Array(pattern.DaysOfWeek), pattern.StartTime,
+		pattern.DurationMinutes, pattern.TimeZone, pq.Array(pattern.ExcludedDates))
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := replaceSessions(ctx, tx, pattern.CourseID, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// replaceSessions replaces the sessions of the course with the given ones and cancels the rooms booked for the dropped ones
+func replaceSessions(ctx context.Context, tx *sql.Tx, courseID int, sessions []*SessionEntity) ([]*SessionEntity, error) {
+	sqlStmt := `DELETE FROM course_sessions WHERE course_id=$1`
+	if _, err := tx.ExecContext(ctx, sqlStmt, courseID); err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `INSERT INTO course_sessions(course_id, session_date, start_time, end_time) VALUES ($1, $2, $3, $4)
+		RETURNING id, to_char(session_date, 'YYYY-MM-DD'), start_time, end_time`
+	saved := make([]*SessionEntity, 0, len(sessions))
+	for _, session := range sessions {
+		entity := SessionEntity{CourseID: courseID}
+		err := tx.QueryRowContext(ctx, sqlStmt, courseID, session.Date, session.StartTime, session.EndTime).
+			Scan(&entity.ID, &entity.Date, &entity.StartTime, &entity.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, &entity)
+	}
+
+	if err := deleteUnscheduledRoomBookings(ctx, tx, courseID); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (_self Course) GetMeetingPattern(id string) (*MeetingPatternEntity, error) {
+	sqlStmt := `SELECT course_id, days_of_week, start_time, duration_minutes, time_zone, excluded_dates
+		FROM course_meeting_patterns WHERE course_id=$1`
+	pattern := MeetingPatternEntity{}
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&pattern.CourseID, pq.Array(&pattern.DaysOfWeek), &pattern.StartTime,
+		&pattern.DurationMinutes, &pattern.TimeZone, pq.Array(&pattern.ExcludedDates))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrMeetingPatternNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pattern, nil
+}
+
+// DeleteMeetingPattern deletes the meeting pattern of the course together with the sessions generated from it
+func (_self Course) DeleteMeetingPattern(id string) error {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	sqlStmt := `DELETE FROM course_meeting_patterns WHERE course_id=$1`
+	result, err := tx.ExecContext(ctx, sqlStmt, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrMeetingPatternNotFound
+	}
+
+	sqlStmt = `DELETE FROM course_sessions WHERE course_id=$1`
+	if _, err = tx.ExecContext(ctx, sqlStmt, id); err != nil {
+		return err
+	}
+
+	if err = deleteUnscheduledRoomBookings(ctx, tx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (_self Course) GetCourseSessions(id string) ([]*SessionEntity, error) {
+	sqlStmt := `SELECT id, course_id, to_char(session_date, 'YYYY-MM-DD'), start_time, end_time
+		FROM course_sessions WHERE course_id=$1 ORDER BY start_time`
+	rows, err := _self.Db.Query(sqlStmt, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []*SessionEntity{}
+	for rows.Next() {
+		var session SessionEntity
+		err = rows.Scan(&session.ID, &session.CourseID, &session.Date, &session.StartTime, &session.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &session)
+	}
+	return sessions, rows.Err()
+}
+
+// GetEnrolledCount counts the students actively enrolled in the course
+func (_self Course) GetEnrolledCount(id string) (int, error) {
+	sqlStmt := `SELECT COUNT(*) FROM students_courses WHERE course_id=$1 AND status=$2`
+	count := 0
+	err := _self.Db.QueryRow(sqlStmt, id, models.EnrollmentStatusActive).Scan(&count)
+	return count, err
+}
+
+// GetCourseTeachers lists the teachers of every course, the primary teacher first as lead and the others by role and name
+func (_self Course) GetCourseTeachers(courseIDs []int) (map[int][]*models.CourseTeacherModel, error) {
+	sqlStmt := `SELECT course_id, teacher_id, first_name, last_name, date_of_birth, role FROM (
+			SELECT c.id AS course_id, t.id AS teacher_id, t.first_name, t.last_name, t.date_of_birth, $2::text AS role, 0 AS rank
+			FROM courses c
+			JOIN teachers t ON t.id = c.teacher_id
+			WHERE c.id = ANY($1)
+			UNION ALL
+			SELECT ct.course_id, t.id, t.first_name, t.last_name, t.date_of_birth, ct.role, 1
+			FROM course_teachers ct
+			JOIN teachers t ON t.id = ct.teacher_id
+			WHERE ct.course_id = ANY($1)
+		) teachers
+		ORDER BY course_id, rank, array_position($3::text[], role), last_name, first_name, teacher_id`
+	rows, err := _self.Db.Query(sqlStmt, pq.Array(courseIDs), models.CourseTeacherRoleLead, pq.Array(models.CourseTeacherRoles))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teachers := map[int][]*models.CourseTeacherModel{}
+	for rows.Next() {
+		courseID := 0
+		teacher := models.CourseTeacherModel{Teacher: &models.TeacherModel{}}
+		err = rows.Scan(&courseID, &teacher.Teacher.ID, &teacher.Teacher.FirstName, &teacher.Teacher.LastName,
+			&teacher.Teacher.DateOfBirth, &teacher.Role)
+		if err != nil {
+			return nil, err
+		}
+		teachers[courseID] = append(teachers[courseID], &teacher)
+	}
+	return teachers, rows.Err()
+}
+
+// SetCourseTeacher adds the teacher to the course with the role, or changes their role when they already teach it
+func (_self Course) SetCourseTeacher(courseID int, teacherID int, role string) error {
+	sqlStmt := `INSERT INTO course_teachers(course_id, teacher_id, role) VALUES ($1, $2, $3)
+		ON CONFLICT (course_id, teacher_id) DO UPDATE SET role = EXCLUDED.role`
+	_, err := _self.Db.Exec(sqlStmt, courseID, teacherID, role)
 	return err
 }
+
+func (_self Course) DeleteCourseTeacher(courseID int, teacherID string) error {
+	sqlStmt := `DELETE FROM course_teachers WHERE course_id=$1 AND teacher_id=$2`
+	result, err := _self.Db.Exec(sqlStmt, courseID, teacherID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrCourseTeacherNotFound
+	}
+	return nil
+}
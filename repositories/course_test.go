@@ -35,12 +35,16 @@ func Test_CreateCourse(t *testing.T) {
 				Name:      "Math",
 				StartTime: "4/23/2020",
 				EndTime:   "4/24/2020",
+				TermID:    1,
 				TeacherID: 1,
 			},
 			expectedValue: &models.CourseModel{
 				Name:      "Math",
 				StartTime: "2020-04-23T00:00:00Z",
 				EndTime:   "2020-04-24T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -69,15 +73,16 @@ func Test_CreateCourse(t *testing.T) {
 				// For Success Logic
 				require.NoError(t, err)
 
-				sqlStmt := `SELECT * FROM courses WHERE id=$1`
+				sqlStmt := `SELECT id, name, start_time, end_time, term_id, teacher_id FROM courses WHERE id=$1`
 				var course CourseEntity
-				err := dbMock.QueryRow(sqlStmt, result.ID).Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime, &course.TeacherID)
+				err := dbMock.QueryRow(sqlStmt, result.ID).Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime, &course.TermID, &course.TeacherID)
 				if err != nil {
 					t.Error(err)
 				}
 				require.Equal(t, testCase.expectedValue.Name, course.Name)
 				require.Equal(t, testCase.expectedValue.StartTime, course.StartTime)
 				require.Equal(t, testCase.expectedValue.EndTime, course.EndTime)
+				require.Equal(t, testCase.expectedValue.Term.ID, course.TermID)
 				require.Equal(t, testCase.expectedValue.Teacher.ID, course.TeacherID)
 			}
 		})
@@ -107,6 +112,13 @@ func Test_GetCourseByID(t *testing.T) {
 				Name:      "Math",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID:        1,
+					Name:      "Fall 2020",
+					StartDate: "2020-08-15T00:00:00Z",
+					EndDate:   "2020-12-31T00:00:00Z",
+					Status:    models.TermStatusActive,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 					FirstName: "Anh",
@@ -237,7 +249,697 @@ func Test_UpdateCourse(t *testing.T) {
 				Db: dbMock,
 			}
 
-			err := courseRepo.UpdateCourse(testCase.inputID, testCase.inputCourse)
+			err := courseRepo.UpdateCourse(testCase.inputID, testCase.inputCourse, nil, models.DefaultMaxCreditLoad)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_GetCourseStudents(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputFilter   *models.RosterFilterModel
+		expectedValue []*StudentEntity
+		expectedTotal int
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "course not found",
+			inputID:       "3",
+			inputFilter:   &models.RosterFilterModel{Limit: 20},
+			expectedValue: nil,
+			expectedError: models.ErrCourseNotFound,
+			giveFixture:   "./testdata/course/course_students.sql",
+		},
+		{
+			name:    "get course students sorted by last name",
+			inputID: "1",
+			inputFilter: &models.RosterFilterModel{
+				Limit:  20,
+				SortBy: models.RosterSortByLastName,
+				Order:  "asc",
+			},
+			expectedValue: []*StudentEntity{
+				{ID: 2, StudentID: "234567", FirstName: "Mai", LastName: "Dao", DateOfBirth: "1998-11-02T00:00:00Z"},
+				{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "Le", DateOfBirth: "1998-11-02T00:00:00Z"},
+			},
+			expectedTotal: 2,
+			expectedError: nil,
+			giveFixture:   "./testdata/course/course_students.sql",
+		},
+		{
+			name:    "get course students page sorted by student id",
+			inputID: "1",
+			inputFilter: &models.RosterFilterModel{
+				Limit:  1,
+				Offset: 1,
+				SortBy: models.RosterSortByStudentID,
+				Order:  "desc",
+			},
+			expectedValue: []*StudentEntity{
+				{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "Le", DateOfBirth: "1998-11-02T00:00:00Z"},
+			},
+			expectedTotal: 2,
+			expectedError: nil,
+			giveFixture:   "./testdata/course/course_students.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			result, total, err := courseRepo.GetCourseStudents(testCase.inputID, testCase.inputFilter)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+				require.Equal(t, testCase.expectedTotal, total)
+			}
+		})
+	}
+}
+
+func Test_GetPrerequisites(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedValue []*models.CourseModel
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "course not found",
+			input:         "4",
+			expectedValue: nil,
+			expectedError: models.ErrCourseNotFound,
+			giveFixture:   "./testdata/course/prerequisite.sql",
+		},
+		{
+			name:  "get prerequisites successfully",
+			input: "2",
+			expectedValue: []*models.CourseModel{
+				{
+					ID:        1,
+					Name:      "Math",
+					StartTime: "2020-11-02T00:00:00Z",
+					EndTime:   "2020-11-03T00:00:00Z",
+					Teacher: &models.TeacherModel{
+						ID:          1,
+						FirstName:   "Anh",
+						LastName:    "Le",
+						DateOfBirth: "1998-11-02T00:00:00Z",
+					},
+				},
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/course/prerequisite.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			result, err := courseRepo.GetPrerequisites(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_AddPrerequisite(t *testing.T) {
+	testCases := []struct {
+		name                string
+		inputID             string
+		inputPrerequisiteID string
+		expectedError       error
+		giveFixture         string
+	}{
+		{
+			name:                "course not found",
+			inputID:             "4",
+			inputPrerequisiteID: "1",
+			expectedError:       models.ErrCourseNotFound,
+			giveFixture:         "./testdata/course/prerequisite.sql",
+		},
+		{
+			name:                "prerequisite already exists",
+			inputID:             "2",
+			inputPrerequisiteID: "1",
+			expectedError:       models.ErrPrerequisiteExists,
+			giveFixture:         "./testdata/course/prerequisite.sql",
+		},
+		{
+			name:                "prerequisite creates a cycle",
+			inputID:             "1",
+			inputPrerequisiteID: "2",
+			expectedError:       models.ErrPrerequisiteCycle,
+			giveFixture:         "./testdata/course/prerequisite.sql",
+		},
+		{
+			name:                "add prerequisite successfully",
+			inputID:             "3",
+			inputPrerequisiteID: "2",
+			expectedError:       nil,
+			giveFixture:         "./testdata/course/prerequisite.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			err := courseRepo.AddPrerequisite(testCase.inputID, testCase.inputPrerequisiteID)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+
+				prerequisites, err := courseRepo.GetPrerequisites("3")
+				require.NoError(t, err)
+				require.Len(t, prerequisites, 1)
+				require.Equal(t, 2, prerequisites[0].ID)
+			}
+		})
+	}
+}
+
+func Test_DeletePrerequisite(t *testing.T) {
+	testCases := []struct {
+		name                string
+		inputID             string
+		inputPrerequisiteID string
+		expectedError       error
+		giveFixture         string
+	}{
+		{
+			name:                "prerequisite not found",
+			inputID:             "3",
+			inputPrerequisiteID: "1",
+			expectedError:       models.ErrPrerequisiteNotFound,
+			giveFixture:         "./testdata/course/prerequisite.sql",
+		},
+		{
+			name:                "delete prerequisite successfully",
+			inputID:             "2",
+			inputPrerequisiteID: "1",
+			expectedError:       nil,
+			giveFixture:         "./testdata/course/prerequisite.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			err := courseRepo.DeletePrerequisite(testCase.inputID, testCase.inputPrerequisiteID)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_BulkEnrollCourse(t *testing.T) {
+	testCases := []struct {
+		name             string
+		inputID          string
+		inputStudentIDs  []int
+		inputMode        string
+		expectedSuccess  []bool
+		expectedErrors   []string
+		expectedCodes    []string
+		expectedEnrolled int
+		expectedError    error
+		giveFixture      string
+	}{
+		{
+			name:            "course not found",
+			inputID:         "2",
+			inputStudentIDs: []int{2},
+			inputMode:       models.BulkEnrollmentAllOrNothing,
+			expectedError:   models.ErrCourseNotFound,
+			giveFixture:     "./testdata/course/bulk_enrollment.sql",
+		},
+		{
+			name:             "all or nothing rolls back",
+			inputID:          "1",
+			inputStudentIDs:  []int{2, 1},
+			inputMode:        models.BulkEnrollmentAllOrNothing,
+			expectedSuccess:  []bool{false, false},
+			expectedErrors:   []string{models.ErrBulkEnrollmentRolledBack.Error(), models.ErrAlreadyEnrolled.Error()},
+			expectedCodes:    []string{models.EnrollmentRolledBack, models.EnrollmentAlreadyEnrolled},
+			expectedEnrolled: 1,
+			expectedError:    nil,
+			giveFixture:      "./testdata/course/bulk_enrollment.sql",
+		},
+		{
+			name:             "best effort keeps successful enrollments",
+			inputID:          "1",
+			inputStudentIDs:  []int{4, 2, 1},
+			inputMode:        models.BulkEnrollmentBestEffort,
+			expectedSuccess:  []bool{false, true, false},
+			expectedErrors:   []string{models.ErrStudentNotFound.Error(), "", models.ErrAlreadyEnrolled.Error()},
+			expectedCodes:    []string{models.EnrollmentStudentNotFound, "", models.EnrollmentAlreadyEnrolled},
+			expectedEnrolled: 2,
+			expectedError:    nil,
+			giveFixture:      "./testdata/course/bulk_enrollment.sql",
+		},
+		{
+			name:             "bulk enroll course successfully",
+			inputID:          "1",
+			inputStudentIDs:  []int{3, 2},
+			inputMode:        models.BulkEnrollmentAllOrNothing,
+			expectedSuccess:  []bool{true, true},
+			expectedErrors:   []string{"", ""},
+			expectedCodes:    []string{"", ""},
+			expectedEnrolled: 3,
+			expectedError:    nil,
+			giveFixture:      "./testdata/course/bulk_enrollment.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			results, err := courseRepo.BulkEnrollCourse(testCase.inputID, testCase.inputStudentIDs, testCase.inputMode, &models.EnrollmentOptionsModel{}, 0)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Len(t, results, len(testCase.inputStudentIDs))
+				for i, result := range results {
+					require.Equal(t, testCase.inputStudentIDs[i], result.StudentID)
+					require.Equal(t, testCase.expectedSuccess[i], result.Success)
+					require.Equal(t, testCase.expectedErrors[i], result.Error)
+					require.Equal(t, testCase.expectedCodes[i], result.Code)
+				}
+
+				_, total, err := courseRepo.GetCourseStudents(testCase.inputID, &models.RosterFilterModel{
+					Limit:  20,
+					SortBy: models.RosterSortByStudentID,
+					Order:  "asc",
+				})
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedEnrolled, total)
+			}
+		})
+	}
+}
+
+func Test_SetRegistrationOverride(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputID        string
+		inputStudentID string
+		expectedError  error
+		giveFixture    string
+	}{
+		{
+			name:           "course not found",
+			inputID:        "2",
+			inputStudentID: "1",
+			expectedError:  models.ErrCourseNotFound,
+			giveFixture:    "./testdata/course/course_students.sql",
+		},
+		{
+			name:           "student not found",
+			inputID:        "1",
+			inputStudentID: "4",
+			expectedError:  models.ErrStudentNotFound,
+			giveFixture:    "./testdata/course/course_students.sql",
+		},
+		{
+			name:           "set registration override successfully",
+			inputID:        "1",
+			inputStudentID: "3",
+			expectedError:  nil,
+			giveFixture:    "./testdata/course/course_students.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			err := courseRepo.SetRegistrationOverride(testCase.inputID, testCase.inputStudentID, "admin")
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+
+				overridden, err := courseRepo.HasRegistrationOverride(testCase.inputID, testCase.inputStudentID)
+				require.NoError(t, err)
+				require.True(t, overridden)
+
+				require.NoError(t, courseRepo.DeleteRegistrationOverride(testCase.inputID, testCase.inputStudentID))
+				require.EqualError(t, courseRepo.DeleteRegistrationOverride(testCase.inputID, testCase.inputStudentID),
+					models.ErrRegistrationOverrideNotFound.Error())
+			}
+		})
+	}
+}
+
+func Test_UpdateCourseReplacesSessions(t *testing.T) {
+	dbMock, _ := testhelpers.ConnectDB()
+
+	utils.LoadFixture(dbMock, "./testdata/course/course.sql")
+
+	courseRepo := Course{
+		Db: dbMock,
+	}
+
+	_, err := courseRepo.SetMeetingPattern(&MeetingPatternEntity{
+		CourseID:        1,
+		DaysOfWeek:      []string{"monday"},
+		StartTime:       "09:00",
+		DurationMinutes: 90,
+		TimeZone:        "UTC",
+		ExcludedDates:   []string{},
+	}, []*SessionEntity{
+		{CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+	})
+	require.NoError(t, err)
+
+	err = courseRepo.UpdateCourse("1", &CourseEntity{
+		Name:      "Math",
+		StartTime: "11/9/2020",
+		EndTime:   "11/10/2020",
+		TermID:    1,
+		TeacherID: 1,
+	}, []*SessionEntity{
+		{CourseID: 1, Date: "2020-11-09", StartTime: "2020-11-09T09:00:00Z", EndTime: "2020-11-09T10:30:00Z"},
+	}, models.DefaultMaxCreditLoad)
+	require.NoError(t, err)
+
+	sessions, err := courseRepo.GetCourseSessions("1")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, "2020-11-09", sessions[0].Date)
+
+	// A failed update keeps the sessions of the course
+	err = courseRepo.UpdateCourse("1", &CourseEntity{
+		Name:      "Math",
+		StartTime: "11/16/2020",
+		TeacherID: 1,
+	}, []*SessionEntity{
+		{CourseID: 1, Date: "2020-11-16", StartTime: "2020-11-16T09:00:00Z", EndTime: "2020-11-16T10:30:00Z"},
+	}, models.DefaultMaxCreditLoad)
+	require.Error(t, err)
+
+	sessions, err = courseRepo.GetCourseSessions("1")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, "2020-11-09", sessions[0].Date)
+}
+
+func Test_SetMeetingPattern(t *testing.T) {
+	dbMock, _ := testhelpers.ConnectDB()
+
+	utils.LoadFixture(dbMock, "./testdata/course/course.sql")
+
+	courseRepo := Course{
+		Db: dbMock,
+	}
+
+	pattern := &MeetingPatternEntity{
+		CourseID:        1,
+		DaysOfWeek:      []string{"monday", "tuesday"},
+		StartTime:       "09:00",
+		DurationMinutes: 90,
+		TimeZone:        "UTC",
+		ExcludedDates:   []string{"2020-11-03"},
+	}
+	_, err := courseRepo.SetMeetingPattern(pattern, []*SessionEntity{
+		{CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+		{CourseID: 1, Date: "2020-11-03", StartTime: "2020-11-03T09:00:00Z", EndTime: "2020-11-03T10:30:00Z"},
+	})
+	require.NoError(t, err)
+
+	// Saving the pattern again replaces the sessions
+	saved, err := courseRepo.SetMeetingPattern(pattern, []*SessionEntity{
+		{CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+	})
+	require.NoError(t, err)
+
+	sessions, err := courseRepo.GetCourseSessions("1")
+	require.NoError(t, err)
+	require.Equal(t, saved, sessions)
+	require.Len(t, sessions, 1)
+	require.Equal(t, "2020-11-02", sessions[0].Date)
+	require.Equal(t, "2020-11-02T09:00:00Z", sessions[0].StartTime)
+
+	result, err := courseRepo.GetMeetingPattern("1")
+	require.NoError(t, err)
+	require.Equal(t, pattern, result)
+
+	require.NoError(t, courseRepo.DeleteMeetingPattern("1"))
+	_, err = courseRepo.GetMeetingPattern("1")
+	require.EqualError(t, err, models.ErrMeetingPatternNotFound.Error())
+}
+
+func Test_GetCourses(t *testing.T) {
+	fall2020 := &models.TermModel{
+		ID:        1,
+		Name:      "Fall 2020",
+		StartDate: "2020-08-15T00:00:00Z",
+		EndDate:   "2020-12-31T00:00:00Z",
+		Status:    models.TermStatusActive,
+	}
+	teacher := &models.TeacherModel{
+		ID:          1,
+		FirstName:   "Anh",
+		LastName:    "Le",
+		DateOfBirth: "1998-11-02T00:00:00Z",
+	}
+	twoEnrolled := 2
+	noneEnrolled := 0
+	math := &models.CourseModel{
+		ID:        1,
+		Name:      "Math",
+		StartTime: "2020-11-02T00:00:00Z",
+		EndTime:   "2020-11-03T00:00:00Z",
+		Term:      fall2020,
+		Teacher:   teacher,
+		Enrolled:  &twoEnrolled,
+	}
+	chemistry := &models.CourseModel{
+		ID:        3,
+		Name:      "Chemistry",
+		StartTime: "2020-12-01T00:00:00Z",
+		EndTime:   "2020-12-02T00:00:00Z",
+		Term:      fall2020,
+		Teacher:   teacher,
+		Enrolled:  &noneEnrolled,
+	}
+
+	testCases := []struct {
+		name          string
+		input         *models.CourseFilterModel
+		expectedValue []*models.CourseModel
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "teacher not found",
+			input:         &models.CourseFilterModel{TeacherID: "9"},
+			expectedValue: nil,
+			expectedError: models.ErrTeacherNotFound,
+			giveFixture:   "./testdata/teacher/teacher_courses.sql",
+		},
+		{
+			name:          "get courses of a teacher",
+			input:         &models.CourseFilterModel{TeacherID: "1"},
+			expectedValue: []*models.CourseModel{math, chemistry},
+			expectedError: nil,
+			giveFixture:   "./testdata/teacher/teacher_courses.sql",
+		},
+		{
+			name:  "get the courses a teacher co-teaches",
+			input: &models.CourseFilterModel{TeacherID: "2"},
+			expectedValue: []*models.CourseModel{
+				{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z", Term: fall2020, Teacher: teacher, Enrolled: &noneEnrolled},
+				{ID: 2, Name: "Physics", StartTime: "2020-11-04T00:00:00Z", EndTime: "2020-11-05T00:00:00Z", Term: fall2020, Teacher: &models.TeacherModel{
+					ID:          2,
+					FirstName:   "Binh",
+					LastName:    "Tran",
+					DateOfBirth: "1995-05-04T00:00:00Z",
+				}, Enrolled: &noneEnrolled},
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/course/course_teachers.sql",
+		},
+		{
+			name:          "get courses of a teacher between dates",
+			input:         &models.CourseFilterModel{TeacherID: "1", TermID: "1", From: "2020-11-02", To: "2020-12-01"},
+			expectedValue: []*models.CourseModel{math, chemistry},
+			expectedError: nil,
+			giveFixture:   "./testdata/teacher/teacher_courses.sql",
+		},
+		{
+			name:          "get courses of a teacher from a date",
+			input:         &models.CourseFilterModel{TeacherID: "1", From: "2020-11-04"},
+			expectedValue: []*models.CourseModel{chemistry},
+			expectedError: nil,
+			giveFixture:   "./testdata/teacher/teacher_courses.sql",
+		},
+		{
+			name:          "get active courses of a teacher",
+			input:         &models.CourseFilterModel{TeacherID: "1", Status: models.CourseStatusActive},
+			expectedValue: []*models.CourseModel{},
+			expectedError: nil,
+			giveFixture:   "./testdata/teacher/teacher_courses.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			result, err := courseRepo.GetCourses(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetCourseTeachers(t *testing.T) {
+	dbMock, _ := testhelpers.ConnectDB()
+
+	utils.LoadFixture(dbMock, "./testdata/course/course_teachers.sql")
+
+	courseRepo := Course{
+		Db: dbMock,
+	}
+
+	result, err := courseRepo.GetCourseTeachers([]int{1, 2})
+
+	require.NoError(t, err)
+	require.Len(t, result[1], 3)
+	require.Equal(t, []int{1, 2, 3}, []int{result[1][0].Teacher.ID, result[1][1].Teacher.ID, result[1][2].Teacher.ID})
+	require.Equal(t, []string{models.CourseTeacherRoleLead, models.CourseTeacherRoleAssistant, models.CourseTeacherRoleGrader},
+		[]string{result[1][0].Role, result[1][1].Role, result[1][2].Role})
+	require.Len(t, result[2], 1)
+	require.Equal(t, models.CourseTeacherRoleLead, result[2][0].Role)
+}
+
+func Test_DeleteCourseTeacher(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputID        int
+		inputTeacherID string
+		expectedError  error
+	}{
+		{
+			name:           "teacher doesn't teach the course",
+			inputID:        2,
+			inputTeacherID: "3",
+			expectedError:  models.ErrCourseTeacherNotFound,
+		},
+		{
+			name:           "delete course teacher successfully",
+			inputID:        1,
+			inputTeacherID: "3",
+			expectedError:  nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/course/course_teachers.sql")
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			err := courseRepo.DeleteCourseTeacher(testCase.inputID, testCase.inputTeacherID)
 
 			if testCase.expectedError != nil {
 				// For Fail Logic
@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"database/sql"
+	"student_rest/models"
+)
+
+type Department struct {
+	Db *sql.DB
+}
+
+type DepartmentRepositories interface {
+	CreateDepartment(department *DepartmentEntity) (*DepartmentEntity, error)
+	GetDepartments() ([]*DepartmentEntity, error)
+	GetDepartmentByID(id string) (*DepartmentEntity, error)
+	UpdateDepartment(id string, department *DepartmentEntity) error
+	DeleteDepartment(id string) error
+}
+
+func (_self Department) CreateDepartment(department *DepartmentEntity) (*DepartmentEntity, error) {
+	sqlStmt := `INSERT INTO departments("code", "name") VALUES ($1, $2) RETURNING id`
+	err := _self.Db.QueryRow(sqlStmt, department.Code, department.Name).Scan(&department.ID)
+	if isUniqueViolation(err) {
+		return nil, models.ErrDepartmentExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return department, nil
+}
+
+func (_self Department) GetDepartments() ([]*DepartmentEntity, error) {
+	sqlStmt := `SELECT id, code, name FROM departments ORDER BY code`
+	rows, err := _self.Db.Query(sqlStmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	departments := []*DepartmentEntity{}
+	for rows.Next() {
+		var department DepartmentEntity
+		if err = rows.Scan(&department.ID, &department.Code, &department.Name); err != nil {
+			return nil, err
+		}
+		departments = append(departments, &department)
+	}
+	return departments, rows.Err()
+}
+
+func (_self Department) GetDepartmentByID(id string) (*DepartmentEntity, error) {
+	sqlStmt := `SELECT id, code, name FROM departments WHERE id=$1`
+	var department DepartmentEntity
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&department.ID, &department.Code, &department.Name)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrDepartmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &department, nil
+}
+
+func (_self Department) UpdateDepartment(id string, department *DepartmentEntity) error {
+	sqlStmt := `UPDATE departments SET "code" = $2, "name" = $3 WHERE id=$1 RETURNING id`
+	departmentID := 0
+	err := _self.Db.QueryRow(sqlStmt, id, department.Code, department.Name).Scan(&departmentID)
+	if err == sql.ErrNoRows {
+		return models.ErrDepartmentNotFound
+	}
+	if isUniqueViolation(err) {
+		return models.ErrDepartmentExists
+	}
+	return err
+}
+
+// DeleteDepartment deletes the department, a department that still has programs, teachers or courses can't be deleted
+func (_self Department) DeleteDepartment(id string) error {
+	sqlStmt := `DELETE FROM departments WHERE id=$1
+		AND NOT EXISTS(SELECT 1 FROM programs WHERE department_id=$1)
+		AND NOT EXISTS(SELECT 1 FROM teachers WHERE department_id=$1)
+		AND NOT EXISTS(SELECT 1 FROM courses WHERE department_id=$1)`
+	result, err := _self.Db.Exec(sqlStmt, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		return nil
+	}
+
+	if _, err = _self.GetDepartmentByID(id); err != nil {
+		return err
+	}
+	return models.ErrDepartmentInUse
+}
+
+// findDepartment returns the id of the department a listing is filtered by
+func findDepartment(db *sql.DB, id string) (int, error) {
+	departmentID := 0
+	err := db.QueryRow(`SELECT id FROM departments WHERE id=$1`, id).Scan(&departmentID)
+	if err == sql.ErrNoRows {
+		return 0, models.ErrDepartmentNotFound
+	}
+	return departmentID, err
+}
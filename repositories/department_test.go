@@ -0,0 +1,202 @@
+package repositories
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/testhelpers"
+	"student_rest/utils"
+	"testing"
+)
+
+func Test_CreateDepartment(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         *DepartmentEntity
+		expectedError error
+	}{
+		{
+			name:          "department already exists",
+			input:         &DepartmentEntity{Code: "MATH", Name: "Applied Mathematics"},
+			expectedError: models.ErrDepartmentExists,
+		},
+		{
+			name:          "create department successfully",
+			input:         &DepartmentEntity{Code: "CHEM", Name: "Chemistry"},
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/department/department.sql")
+
+			departmentRepo := Department{
+				Db: dbMock,
+			}
+
+			result, err := departmentRepo.CreateDepartment(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, 4, result.ID)
+			}
+		})
+	}
+}
+
+func Test_DeleteDepartment(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		expectedError error
+	}{
+		{
+			name:          "department not found",
+			inputID:       "9",
+			expectedError: models.ErrDepartmentNotFound,
+		},
+		{
+			name:          "department has a teacher and a course",
+			inputID:       "1",
+			expectedError: models.ErrDepartmentInUse,
+		},
+		{
+			name:          "department has a program",
+			inputID:       "2",
+			expectedError: models.ErrDepartmentInUse,
+		},
+		{
+			name:          "delete department successfully",
+			inputID:       "3",
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/department/department.sql")
+
+			departmentRepo := Department{
+				Db: dbMock,
+			}
+
+			err := departmentRepo.DeleteDepartment(testCase.inputID)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_GetTeachersByDepartment(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         *models.TeacherFilterModel
+		expectedIDs   []int
+		expectedError error
+	}{
+		{
+			name:          "department not found",
+			input:         &models.TeacherFilterModel{DepartmentID: "9"},
+			expectedError: models.ErrDepartmentNotFound,
+		},
+		{
+			name:        "list every teacher",
+			input:       &models.TeacherFilterModel{},
+			expectedIDs: []int{2, 1},
+		},
+		{
+			name:        "list the teachers of the department",
+			input:       &models.TeacherFilterModel{DepartmentID: "1"},
+			expectedIDs: []int{1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/department/department.sql")
+
+			teacherRepo := Teacher{
+				Db: dbMock,
+			}
+
+			result, err := teacherRepo.GetTeachers(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				ids := []int{}
+				for _, teacher := range result {
+					ids = append(ids, teacher.ID)
+				}
+				require.Equal(t, testCase.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func Test_GetCoursesByDepartment(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         *models.CourseFilterModel
+		expectedIDs   []int
+		expectedError error
+	}{
+		{
+			name:          "department not found",
+			input:         &models.CourseFilterModel{DepartmentID: "9"},
+			expectedError: models.ErrDepartmentNotFound,
+		},
+		{
+			name:        "list the courses of the department",
+			input:       &models.CourseFilterModel{DepartmentID: "1"},
+			expectedIDs: []int{1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/department/department.sql")
+
+			courseRepo := Course{
+				Db: dbMock,
+			}
+
+			result, err := courseRepo.GetCourses(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				ids := []int{}
+				for _, course := range result {
+					ids = append(ids, course.ID)
+					require.Equal(t, 1, *course.DepartmentID)
+				}
+				require.Equal(t, testCase.expectedIDs, ids)
+			}
+		})
+	}
+}
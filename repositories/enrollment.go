@@ -0,0 +1,252 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"student_rest/models"
+)
+
+type Enrollment struct {
+	Db *sql.DB
+}
+
+type EnrollmentRepositories interface {
+	GetEnrollmentByID(id string) (*EnrollmentEntity, error)
+	GetEnrollmentHistory(id string) ([]*EnrollmentStatusChangeEntity, error)
+	UpdateEnrollmentStatus(id string, from string, change *models.EnrollmentStatusChangeModel, maxCreditLoad int) (*EnrollmentEntity, error)
+}
+
+func (_self Enrollment) GetEnrollmentByID(id string) (*EnrollmentEntity, error) {
+	sqlStmt := `SELECT id, student_id, course_id, status, withdrawal_reason, withdrawn_at, withdrawn_by
+		FROM students_courses WHERE id=$1`
+	var enrollment EnrollmentEntity
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID,
+		&enrollment.Status, &enrollment.WithdrawalReason, &enrollment.WithdrawnAt, &enrollment.WithdrawnBy)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrEnrollmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &enrollment, nil
+}
+
+// GetEnrollmentHistory returns the status transitions of the enrollment, oldest first
+func (_self Enrollment) GetEnrollmentHistory(id string) ([]*EnrollmentStatusChangeEntity, error) {
+	sqlStmt := `SELECT id, from_status, to_status, reason, changed_by, changed_at
+		FROM enrollment_status_history WHERE enrollment_id=$1 ORDER BY id`
+	rows, err := _self.Db.Query(sqlStmt, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []*EnrollmentStatusChangeEntity{}
+	for rows.Next() {
+		var change EnrollmentStatusChangeEntity
+		err = rows.Scan(&change.ID, &change.FromStatus, &change.ToStatus, &change.Reason, &change.ChangedBy, &change.ChangedAt)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, &change)
+	}
+	return history, rows.Err()
+}
+
+// UpdateEnrollmentStatus moves the enrollment from the given status to the new one and records the transition.
+// It returns ErrEnrollmentStatusChanged when the enrollment is no longer in the given status. An enrollment becoming
+// active must fit the credit limit of the student, and a freed seat goes to the waitlisted students within maxCreditLoad.
+func (_self Enrollment) UpdateEnrollmentStatus(id string, from string, change *models.EnrollmentStatusChangeModel, maxCreditLoad int) (*EnrollmentEntity, error) {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	sqlStmt := `SELECT course_id, student_id FROM students_courses WHERE id=$1`
+	courseID, enrollmentStudentID := 0, 0
+	err = tx.QueryRowContext(ctx, sqlStmt, id).Scan(&courseID, &enrollmentStudentID)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrEnrollmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	course, err := lockCourse(ctx, tx, strconv.Itoa(courseID))
+	if err != nil {
+		return nil, err
+	}
+
+	if change.Status == models.EnrollmentStatusActive {
+		if course.Capacity != nil {
+			enrolled, err := countActiveEnrollments(ctx, tx, course.ID)
+			if err != nil {
+				return nil, err
+			}
+			if enrolled >= *course.Capacity {
+				return nil, models.ErrCourseFull
+			}
+		}
+
+		studentID, err := lockStudent(ctx, tx, enrollmentStudentID)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkCreditLimit(ctx, tx, studentID, course, maxCreditLoad); err != nil {
+			return nil, err
+		}
+	}
+
+	sqlStmt = `UPDATE students_courses SET "status" = $3 WHERE id=$1 AND status=$2
+		RETURNING id, student_id, course_id, status, withdrawal_reason, withdrawn_at, withdrawn_by`
+	args := []interface{}{id, from, change.Status}
+	if change.Status == models.EnrollmentStatusWithdrawn {
+		sqlStmt = `UPDATE students_courses SET "status" = $3, "withdrawal_reason" = $4, "withdrawn_at" = now(), "withdrawn_by" = $5
+			WHERE id=$1 AND status=$2
+			RETURNING id, student_id, course_id, status, withdrawal_reason, withdrawn_at, withdrawn_by`
+		args = append(args, change.Reason, change.PerformedBy)
+	}
+
+	var enrollment EnrollmentEntity
+	err = tx.QueryRowContext(ctx, sqlStmt, args...).Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID,
+		&enrollment.Status, &enrollment.WithdrawalReason, &enrollment.WithdrawnAt, &enrollment.WithdrawnBy)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrEnrollmentStatusChanged
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = recordStatusChange(ctx, tx, enrollment.ID, from, enrollment.Status, change.Reason, change.PerformedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// A dropped student frees their seat for the waitlist
+	if from == models.EnrollmentStatusActive && enrollment.Status == models.EnrollmentStatusWithdrawn {
+		if err = promoteWaitlisted(ctx, tx, course.ID, maxCreditLoad); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &enrollment, nil
+}
+
+// Enrollments lock the course row before the student row, every enrollment path must keep that order
+// so concurrent enrollments can't deadlock.
+
+// lockCourse locks the course row so concurrent enrollments can't take the same seat
+func lockCourse(ctx context.Context, tx *sql.Tx, courseID string) (*CourseEntity, error) {
+	sqlStmt := `SELECT id, capacity, credits, term_id FROM courses WHERE id=$1 FOR UPDATE`
+	var course CourseEntity
+	err := tx.QueryRowContext(ctx, sqlStmt, courseID).Scan(&course.ID, &course.Capacity, &course.Credits, &course.TermID)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrCourseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &course, nil
+}
+
+// lockStudent locks the student row so concurrent enrollments of the same student are serialized
+func lockStudent(ctx context.Context, tx *sql.Tx, studentID interface{}) (int, error) {
+	sqlStmt := `SELECT id FROM students WHERE id=$1 FOR UPDATE`
+	id := 0
+	err := tx.QueryRowContext(ctx, sqlStmt, studentID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, models.ErrStudentNotFound
+	}
+	return id, err
+}
+
+// enrollStudent locks the student and enrolls them into the locked course
+func enrollStudent(ctx context.Context, tx *sql.Tx, studentID int, course *CourseEntity,
+	options *models.EnrollmentOptionsModel, maxCreditLoad int) (*EnrollmentEntity, error) {
+	lockedStudentID, err := lockStudent(ctx, tx, studentID)
+	if err != nil {
+		return nil, err
+	}
+	return enroll(ctx, tx, lockedStudentID, course, options, maxCreditLoad)
+}
+
+// enroll writes the enrollment of a locked student into a locked course, the student goes on the waitlist when the course is full.
+// Any enrollment of the student in the course that hasn't reached a final status counts as already enrolled.
+// The course must not take the student over their credit limit, see checkCreditLimit. The history of the enrollment
+// starts with who overrode the checks of options.
+func enroll(ctx context.Context, tx *sql.Tx, studentID int, course *CourseEntity,
+	options *models.EnrollmentOptionsModel, maxCreditLoad int) (*EnrollmentEntity, error) {
+	sqlStmt := `SELECT status FROM students_courses
+		WHERE student_id=$1 AND course_id=$2 AND status NOT IN ($3, $4, $5)
+		ORDER BY id DESC LIMIT 1`
+	status := ""
+	err := tx.QueryRowContext(ctx, sqlStmt, studentID, course.ID,
+		models.EnrollmentStatusWithdrawn, models.EnrollmentStatusCompleted, models.EnrollmentStatusFailed).Scan(&status)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if status == models.EnrollmentStatusWaitlisted {
+		return nil, models.ErrAlreadyWaitlisted
+	}
+	if status != "" {
+		return nil, models.ErrAlreadyEnrolled
+	}
+	if err = checkCreditLimit(ctx, tx, studentID, course, maxCreditLoad); err != nil {
+		return nil, err
+	}
+
+	status = models.EnrollmentStatusActive
+	if course.Capacity != nil {
+		enrolled, err := countActiveEnrollments(ctx, tx, course.ID)
+		if err != nil {
+			return nil, err
+		}
+		if enrolled >= *course.Capacity {
+			status = models.EnrollmentStatusWaitlisted
+		}
+	}
+
+	enrollment := EnrollmentEntity{
+		StudentID: studentID,
+		CourseID:  course.ID,
+	}
+	sqlStmt = `INSERT INTO students_courses(student_id, course_id, status) VALUES ($1, $2, $3) RETURNING id, status`
+	err = tx.QueryRowContext(ctx, sqlStmt, studentID, course.ID, status).Scan(&enrollment.ID, &enrollment.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	reason, changedBy := "", ""
+	if options != nil && options.OverrideScheduleConflict {
+		reason, changedBy = "schedule conflict check overridden", options.OverriddenBy
+	}
+	if err = recordStatusChange(ctx, tx, enrollment.ID, "", enrollment.Status, reason, changedBy); err != nil {
+		return nil, err
+	}
+
+	if enrollment.Status == models.EnrollmentStatusWaitlisted {
+		position, err := waitlistPosition(ctx, tx, enrollment.ID, course.ID)
+		if err != nil {
+			return nil, err
+		}
+		enrollment.WaitlistPosition = &position
+	}
+	return &enrollment, nil
+}
+
+// recordStatusChange appends a transition to the enrollment status history, from is empty for a new enrollment
+func recordStatusChange(ctx context.Context, tx *sql.Tx, enrollmentID int, from string, to string, reason string, changedBy string) error {
+	sqlStmt := `INSERT INTO enrollment_status_history(enrollment_id, from_status, to_status, reason, changed_by)
+		VALUES ($1, NULLIF($2, ''), $3, NULLIF($4, ''), NULLIF($5, ''))`
+	_, err := tx.ExecContext(ctx, sqlStmt, enrollmentID, from, to, reason, changedBy)
+	return err
+}
@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/testhelpers"
+	"student_rest/utils"
+	"testing"
+)
+
+func Test_GetEnrollmentByID(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedValue *EnrollmentEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "enrollment not found",
+			input:         "9",
+			expectedValue: nil,
+			expectedError: models.ErrEnrollmentNotFound,
+			giveFixture:   "./testdata/enrollment/enrollment.sql",
+		},
+		{
+			name:  "get enrollment by id successfully",
+			input: "3",
+			expectedValue: &EnrollmentEntity{
+				ID:        3,
+				StudentID: 3,
+				CourseID:  1,
+				Status:    "completed",
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/enrollment/enrollment.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			enrollmentRepo := Enrollment{
+				Db: dbMock,
+			}
+
+			result, err := enrollmentRepo.GetEnrollmentByID(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+
+				history, err := enrollmentRepo.GetEnrollmentHistory(testCase.input)
+				require.NoError(t, err)
+				require.Len(t, history, 2)
+				require.Nil(t, history[0].FromStatus)
+				require.Equal(t, "completed", history[1].ToStatus)
+			}
+		})
+	}
+}
+
+func Test_UpdateEnrollmentStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputFrom     string
+		inputChange   *models.EnrollmentStatusChangeModel
+		expectedValue *EnrollmentEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "enrollment not found",
+			inputID:       "9",
+			inputFrom:     "active",
+			inputChange:   &models.EnrollmentStatusChangeModel{Status: "completed"},
+			expectedValue: nil,
+			expectedError: models.ErrEnrollmentNotFound,
+			giveFixture:   "./testdata/enrollment/enrollment.sql",
+		},
+		{
+			name:          "status changed by another request",
+			inputID:       "1",
+			inputFrom:     "waitlisted",
+			inputChange:   &models.EnrollmentStatusChangeModel{Status: "withdrawn", Reason: "moved", PerformedBy: "admin"},
+			expectedValue: nil,
+			expectedError: models.ErrEnrollmentStatusChanged,
+			giveFixture:   "./testdata/enrollment/enrollment.sql",
+		},
+		{
+			name:          "course full",
+			inputID:       "2",
+			inputFrom:     "waitlisted",
+			inputChange:   &models.EnrollmentStatusChangeModel{Status: "active"},
+			expectedValue: nil,
+			expectedError: models.ErrCourseFull,
+			giveFixture:   "./testdata/enrollment/enrollment.sql",
+		},
+		{
+			name:        "update enrollment status successfully",
+			inputID:     "1",
+			inputFrom:   "active",
+			inputChange: &models.EnrollmentStatusChangeModel{Status: "withdrawn", Reason: "moved", PerformedBy: "admin"},
+			expectedValue: &EnrollmentEntity{
+				ID:        1,
+				StudentID: 1,
+				CourseID:  1,
+				Status:    "withdrawn",
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/enrollment/enrollment.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			enrollmentRepo := Enrollment{
+				Db: dbMock,
+			}
+
+			result, err := enrollmentRepo.UpdateEnrollmentStatus(testCase.inputID, testCase.inputFrom, testCase.inputChange, models.DefaultMaxCreditLoad)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue.ID, result.ID)
+				require.Equal(t, testCase.expectedValue.Status, result.Status)
+				require.Equal(t, testCase.inputChange.Reason, *result.WithdrawalReason)
+
+				history, err := enrollmentRepo.GetEnrollmentHistory(testCase.inputID)
+				require.NoError(t, err)
+				require.Equal(t, testCase.inputFrom, *history[len(history)-1].FromStatus)
+				require.Equal(t, testCase.inputChange.Status, history[len(history)-1].ToStatus)
+
+				// The freed seat goes to the waitlisted student
+				promoted, err := enrollmentRepo.GetEnrollmentByID("2")
+				require.NoError(t, err)
+				require.Equal(t, "active", promoted.Status)
+			}
+		})
+	}
+}
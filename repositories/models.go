@@ -6,13 +6,18 @@ type StudentEntity struct {
 	FirstName   string `json:"firstName"`
 	LastName    string `json:"lastName"`
 	DateOfBirth string `json:"dateOfBirth"`
+
+	ProgramID *int `json:"programID,omitempty"`
 }
 
 type TeacherEntity struct {
-	ID          int    `json:"id"`
-	FirstName   string `json:"firstName"`
-	LastName    string `json:"lastName"`
-	DateOfBirth string `json:"dateOfBirth"`
+	ID          int     `json:"id"`
+	FirstName   string  `json:"firstName"`
+	LastName    string  `json:"lastName"`
+	DateOfBirth string  `json:"dateOfBirth"`
+	ArchivedAt  *string `json:"archivedAt"`
+
+	DepartmentID *int `json:"departmentID"`
 }
 
 type CourseEntity struct {
@@ -20,5 +25,249 @@ type CourseEntity struct {
 	Name      string `json:"name"`
 	StartTime string `json:"startTime"`
 	EndTime   string `json:"endTime"`
-	TeacherID int    `json:"teacherID"`
+	Capacity  *int   `json:"capacity"`
+	Credits   int    `json:"credits"`
+
+	RegistrationOpensAt  *string `json:"registrationOpensAt"`
+	RegistrationClosesAt *string `json:"registrationClosesAt"`
+
+	TermID       int  `json:"termID"`
+	TeacherID    int  `json:"teacherID"`
+	DepartmentID *int `json:"departmentID"`
+}
+
+type TermEntity struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	Status    string `json:"status"`
+}
+
+// MeetingPatternEntity is the weekly schedule of a course, Sessions is only set when the pattern was just saved
+type MeetingPatternEntity struct {
+	CourseID        int      `json:"courseID"`
+	DaysOfWeek      []string `json:"daysOfWeek"`
+	StartTime       string   `json:"startTime"`
+	DurationMinutes int      `json:"durationMinutes"`
+	TimeZone        string   `json:"timeZone"`
+	ExcludedDates   []string `json:"excludedDates"`
+
+	Sessions []*SessionEntity `json:"sessions,omitempty"`
+}
+
+// SessionEntity is one meeting of a course, Date is the local YYYY-MM-DD date in the time zone of the meeting pattern
+type SessionEntity struct {
+	ID        int    `json:"id"`
+	CourseID  int    `json:"courseID"`
+	Date      string `json:"date"`
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
+
+type EnrollmentEntity struct {
+	ID               int     `json:"id"`
+	StudentID        int     `json:"studentID"`
+	CourseID         int     `json:"courseID"`
+	Status           string  `json:"status"`
+	WithdrawalReason *string `json:"withdrawalReason,omitempty"`
+	WithdrawnAt      *string `json:"withdrawnAt,omitempty"`
+	WithdrawnBy      *string `json:"withdrawnBy,omitempty"`
+	WaitlistPosition *int    `json:"waitlistPosition,omitempty"`
+
+	GradeLetter *string  `json:"gradeLetter,omitempty"`
+	GradeScore  *float64 `json:"gradeScore,omitempty"`
+	GradedAt    *string  `json:"gradedAt,omitempty"`
+
+	History []*EnrollmentStatusChangeEntity `json:"history,omitempty"`
+}
+
+type EnrollmentStatusChangeEntity struct {
+	ID         int     `json:"id"`
+	FromStatus *string `json:"fromStatus"`
+	ToStatus   string  `json:"toStatus"`
+	Reason     *string `json:"reason,omitempty"`
+	ChangedBy  *string `json:"changedBy,omitempty"`
+	ChangedAt  string  `json:"changedAt"`
+}
+
+type BulkEnrollmentResultEntity struct {
+	StudentID   int               `json:"studentID,omitempty"`
+	StudentCode string            `json:"studentCode,omitempty"`
+	Success     bool              `json:"success"`
+	Enrollment  *EnrollmentEntity `json:"enrollment,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	Code        string            `json:"code,omitempty"`
+	Details     interface{}       `json:"details,omitempty"`
+}
+
+
+// TranscriptCourseEntity is one enrollment on a student transcript, Term and GradePoints are filled in by the service
+type TranscriptCourseEntity struct {
+	EnrollmentID int      `json:"enrollmentID"`
+	CourseID     int      `json:"courseID"`
+	CourseName   string   `json:"courseName"`
+	Credits      int      `json:"credits"`
+	Term         string   `json:"term"`
+	StartTime    string   `json:"startTime"`
+	EndTime      string   `json:"endTime"`
+	Status       string   `json:"status"`
+	GradeLetter  *string  `json:"gradeLetter"`
+	GradeScore   *float64 `json:"gradeScore"`
+	GradePoints  *float64 `json:"gradePoints"`
+}
+
+type TermGPAEntity struct {
+	Term    string  `json:"term"`
+	GPA     float64 `json:"gpa"`
+	Courses int     `json:"courses"`
+}
+
+type TranscriptEntity struct {
+	Student       *StudentEntity            `json:"student"`
+	Courses       []*TranscriptCourseEntity `json:"courses"`
+	Terms         []*TermGPAEntity          `json:"terms"`
+	CumulativeGPA *float64                  `json:"cumulativeGPA"`
+}
+
+type AttendanceEntity struct {
+	ID           int     `json:"id"`
+	EnrollmentID int     `json:"enrollmentID"`
+	StudentID    int     `json:"studentID"`
+	SessionDate  string  `json:"sessionDate"`
+	Status       string  `json:"status"`
+	Note         *string `json:"note,omitempty"`
+	RecordedBy   string  `json:"recordedBy"`
+	RecordedAt   string  `json:"recordedAt"`
+}
+
+// AttendanceSummaryEntity counts the recorded sessions by attendance status, Rate is filled in by the service
+type AttendanceSummaryEntity struct {
+	Sessions int      `json:"sessions"`
+	Present  int      `json:"present"`
+	Absent   int      `json:"absent"`
+	Late     int      `json:"late"`
+	Excused  int      `json:"excused"`
+	Rate     *float64 `json:"rate"`
+}
+
+type StudentAttendanceEntity struct {
+	Student    *StudentEntity           `json:"student"`
+	Attendance *AttendanceSummaryEntity `json:"attendance"`
+}
+
+// CourseAttendanceEntity is the attendance of the enrolled students of a course, Total is filled in by the service
+type CourseAttendanceEntity struct {
+	CourseID     int                        `json:"courseID"`
+	SessionsHeld int                        `json:"sessionsHeld"`
+	Total        *AttendanceSummaryEntity   `json:"total"`
+	Students     []*StudentAttendanceEntity `json:"students"`
+}
+
+type CourseAttendanceSummaryEntity struct {
+	CourseID   int                      `json:"courseID"`
+	CourseName string                   `json:"courseName"`
+	Attendance *AttendanceSummaryEntity `json:"attendance"`
+}
+
+// StudentAttendanceSummaryEntity is the attendance of a student per enrolled course, Total is filled in by the service
+type StudentAttendanceSummaryEntity struct {
+	Student *StudentEntity                   `json:"student"`
+	Total   *AttendanceSummaryEntity         `json:"total"`
+	Courses []*CourseAttendanceSummaryEntity `json:"courses"`
+}
+
+type RoomEntity struct {
+	ID       int      `json:"id"`
+	Building string   `json:"building"`
+	Number   string   `json:"number"`
+	Capacity int      `json:"capacity"`
+	Features []string `json:"features"`
+}
+
+// CourseRoomEntity is a room booked by a course, for all of its sessions or only the session on Date
+type CourseRoomEntity struct {
+	CourseID int         `json:"courseID"`
+	Date     *string     `json:"date"`
+	Room     *RoomEntity `json:"room"`
+}
+
+// RoomBookingEntity is a time the room is taken, Date is nil when a course without sessions takes it for its whole time span
+type RoomBookingEntity struct {
+	RoomID     int     `json:"roomID"`
+	CourseID   int     `json:"courseID"`
+	CourseName string  `json:"courseName"`
+	Date       *string `json:"date"`
+	StartTime  string  `json:"startTime"`
+	EndTime    string  `json:"endTime"`
+}
+
+type DepartmentEntity struct {
+	ID   int    `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type ProgramEntity struct {
+	ID           int    `json:"id"`
+	DepartmentID int    `json:"departmentID"`
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	Degree       string `json:"degree"`
+}
+
+type ProgramRequirementEntity struct {
+	ID        int                        `json:"id"`
+	ProgramID int                        `json:"programID"`
+	Name      string                     `json:"name"`
+	Kind      string                     `json:"kind"`
+	Credits   *int                       `json:"credits"`
+	MinGrade  *string                    `json:"minGrade"`
+	Courses   []*RequirementCourseEntity `json:"courses"`
+}
+
+type RequirementCourseEntity struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Credits int    `json:"credits"`
+}
+
+// CourseHistoryEntity is one enrollment of a student with the credits of its course
+type CourseHistoryEntity struct {
+	CourseID    int
+	CourseName  string
+	Credits     int
+	Status      string
+	GradeLetter *string
+	GradeScore  *float64
+}
+
+// AuditEntity holds the requirements of the student's program grouped by how far the student got with them
+type AuditEntity struct {
+	Student    *StudentEntity            `json:"student"`
+	Program    *ProgramEntity            `json:"program"`
+	Satisfied  []*RequirementAuditEntity `json:"satisfied"`
+	InProgress []*RequirementAuditEntity `json:"inProgress"`
+	Missing    []*RequirementAuditEntity `json:"missing"`
+}
+
+type RequirementAuditEntity struct {
+	ID                int                  `json:"id"`
+	Name              string               `json:"name"`
+	Kind              string               `json:"kind"`
+	Status            string               `json:"status"`
+	MinGrade          *string              `json:"minGrade"`
+	RequiredCredits   *int                 `json:"requiredCredits"`
+	EarnedCredits     int                  `json:"earnedCredits"`
+	InProgressCredits int                  `json:"inProgressCredits"`
+	Courses           []*CourseAuditEntity `json:"courses"`
+}
+
+type CourseAuditEntity struct {
+	CourseID    int      `json:"courseID"`
+	CourseName  string   `json:"courseName"`
+	Credits     int      `json:"credits"`
+	Status      string   `json:"status"`
+	GradeLetter *string  `json:"gradeLetter,omitempty"`
+	GradeScore  *float64 `json:"gradeScore,omitempty"`
 }
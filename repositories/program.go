@@ -0,0 +1,325 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"student_rest/models"
+
+	"github.com/lib/pq"
+)
+
+type Program struct {
+	Db *sql.DB
+}
+
+type ProgramRepositories interface {
+	CreateProgram(program *ProgramEntity) (*ProgramEntity, error)
+	GetPrograms(filter *models.ProgramFilterModel) ([]*ProgramEntity, error)
+	GetProgramByID(id string) (*ProgramEntity, error)
+	UpdateProgram(id string, program *ProgramEntity) error
+	DeleteProgram(id string) error
+	GetProgramRequirements(id string) ([]*ProgramRequirementEntity, error)
+	CreateProgramRequirement(id string, requirement *ProgramRequirementEntity) (*ProgramRequirementEntity, error)
+	UpdateProgramRequirement(id string, requirementID string, requirement *ProgramRequirementEntity) error
+	DeleteProgramRequirement(id string, requirementID string) error
+}
+
+func (_self Program) CreateProgram(program *ProgramEntity) (*ProgramEntity, error) {
+	sqlStmt := `INSERT INTO programs("department_id", "code", "name", "degree") VALUES ($1, $2, $3, $4) RETURNING id`
+	err := _self.Db.QueryRow(sqlStmt, program.DepartmentID, program.Code, program.Name, program.Degree).Scan(&program.ID)
+	if isUniqueViolation(err) {
+		return nil, models.ErrProgramExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return program, nil
+}
+
+// GetPrograms lists the programs ordered by code, only the ones of a department when the filter has one
+func (_self Program) GetPrograms(filter *models.ProgramFilterModel) ([]*ProgramEntity, error) {
+	sqlStmt := `SELECT id, department_id, code, name, degree FROM programs`
+	args := []interface{}{}
+	if filter.DepartmentID != "" {
+		departmentID, err := findDepartment(_self.Db, filter.DepartmentID)
+		if err != nil {
+			return nil, err
+		}
+		sqlStmt += ` WHERE department_id = $1`
+		args = append(args, departmentID)
+	}
+	sqlStmt += ` ORDER BY code`
+
+	rows, err := _self.Db.Query(sqlStmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	programs := []*ProgramEntity{}
+	for rows.Next() {
+		var program ProgramEntity
+		if err = rows.Scan(&program.ID, &program.DepartmentID, &program.Code, &program.Name, &program.Degree); err != nil {
+			return nil, err
+		}
+		programs = append(programs, &program)
+	}
+	return programs, rows.Err()
+}
+
+func (_self Program) GetProgramByID(id string) (*ProgramEntity, error) {
+	sqlStmt := `SELECT id, department_id, code, name, degree FROM programs WHERE id=$1`
+	var program ProgramEntity
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&program.ID, &program.DepartmentID, &program.Code, &program.Name, &program.Degree)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrProgramNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &program, nil
+}
+
+func (_self Program) UpdateProgram(id string, program *ProgramEntity) error {
+	sqlStmt := `UPDATE programs SET "department_id" = $2, "code" = $3, "name" = $4, "degree" = $5 WHERE id=$1 RETURNING id`
+	programID := 0
+	err := _self.Db.QueryRow(sqlStmt, id, program.DepartmentID, program.Code, program.Name, program.Degree).Scan(&programID)
+	if err == sql.ErrNoRows {
+		return models.ErrProgramNotFound
+	}
+	if isUniqueViolation(err) {
+		return models.ErrProgramExists
+	}
+	return err
+}
+
+// DeleteProgram deletes the program with its requirements, a program that still has students can't be deleted
+func (_self Program) DeleteProgram(id string) error {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	programID, err := lockProgram(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	hasStudents := false
+	sqlStmt := `SELECT EXISTS(SELECT 1 FROM students WHERE program_id=$1)`
+	if err = tx.QueryRowContext(ctx, sqlStmt, programID).Scan(&hasStudents); err != nil {
+		return err
+	}
+	if hasStudents {
+		return models.ErrProgramHasStudents
+	}
+
+	sqlStmt = `DELETE FROM program_requirement_courses
+		WHERE requirement_id IN (SELECT id FROM program_requirements WHERE program_id=$1)`
+	if _, err = tx.ExecContext(ctx, sqlStmt, programID); err != nil {
+		return err
+	}
+
+	sqlStmt = `DELETE FROM program_requirements WHERE program_id=$1`
+	if _, err = tx.ExecContext(ctx, sqlStmt, programID); err != nil {
+		return err
+	}
+
+	sqlStmt = `DELETE FROM programs WHERE id=$1`
+	if _, err = tx.ExecContext(ctx, sqlStmt, programID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetProgramRequirements lists the requirements of the program with their courses
+func (_self Program) GetProgramRequirements(id string) ([]*ProgramRequirementEntity, error) {
+	if _, err := _self.GetProgramByID(id); err != nil {
+		return nil, err
+	}
+	return getProgramRequirements(_self.Db, `r.program_id = $1`, id)
+}
+
+// getProgramRequirements loads the requirements matching the condition on r with their courses ordered by course id
+func getProgramRequirements(db *sql.DB, condition string, args ...interface{}) ([]*ProgramRequirementEntity, error) {
+	sqlStmt := `SELECT r.id, r.program_id, r.name, r.kind, r.credits, r.min_grade, c.id, c.name, c.credits
+		FROM program_requirements r
+		JOIN program_requirement_courses rc ON rc.requirement_id = r.id
+		JOIN courses c ON c.id = rc.course_id
+		WHERE ` + condition + `
+		ORDER BY r.id, c.id`
+	rows, err := db.Query(sqlStmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requirements := []*ProgramRequirementEntity{}
+	var requirement *ProgramRequirementEntity
+	for rows.Next() {
+		var row ProgramRequirementEntity
+		var course RequirementCourseEntity
+		err = rows.Scan(&row.ID, &row.ProgramID, &row.Name, &row.Kind, &row.Credits, &row.MinGrade,
+			&course.ID, &course.Name, &course.Credits)
+		if err != nil {
+			return nil, err
+		}
+
+		if requirement == nil || requirement.ID != row.ID {
+			requirement = &row
+			requirement.Courses = []*RequirementCourseEntity{}
+			requirements = append(requirements, requirement)
+		}
+		requirement.Courses = append(requirement.Courses, &course)
+	}
+	return requirements, rows.Err()
+}
+
+func (_self Program) CreateProgramRequirement(id string, requirement *ProgramRequirementEntity) (*ProgramRequirementEntity, error) {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	programID, err := lockProgram(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt := `INSERT INTO program_requirements(program_id, name, kind, credits, min_grade) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`
+	requirementID := 0
+	err = tx.QueryRowContext(ctx, sqlStmt, programID, requirement.Name, requirement.Kind, requirement.Credits, requirement.MinGrade).
+		Scan(&requirementID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = setRequirementCourses(ctx, tx, requirementID, requirement.Courses); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	requirements, err := getProgramRequirements(_self.Db, `r.id = $1`, requirementID)
+	if err != nil {
+		return nil, err
+	}
+	return requirements[0], nil
+}
+
+// UpdateProgramRequirement replaces the rule and the courses of the requirement
+func (_self Program) UpdateProgramRequirement(id string, requirementID string, requirement *ProgramRequirementEntity) error {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	programID, err := lockProgram(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	sqlStmt := `UPDATE program_requirements SET "name" = $3, "kind" = $4, "credits" = $5, "min_grade" = $6
+		WHERE id=$1 AND program_id=$2 RETURNING id`
+	updatedID := 0
+	err = tx.QueryRowContext(ctx, sqlStmt, requirementID, programID, requirement.Name, requirement.Kind, requirement.Credits,
+		requirement.MinGrade).Scan(&updatedID)
+	if err == sql.ErrNoRows {
+		return models.ErrProgramRequirementNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	sqlStmt = `DELETE FROM program_requirement_courses WHERE requirement_id=$1`
+	if _, err = tx.ExecContext(ctx, sqlStmt, updatedID); err != nil {
+		return err
+	}
+
+	if err = setRequirementCourses(ctx, tx, updatedID, requirement.Courses); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (_self Program) DeleteProgramRequirement(id string, requirementID string) error {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	programID, err := lockProgram(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	sqlStmt := `DELETE FROM program_requirement_courses rc USING program_requirements r
+		WHERE rc.requirement_id = r.id AND r.id=$1 AND r.program_id=$2`
+	if _, err = tx.ExecContext(ctx, sqlStmt, requirementID, programID); err != nil {
+		return err
+	}
+
+	sqlStmt = `DELETE FROM program_requirements WHERE id=$1 AND program_id=$2`
+	result, err := tx.ExecContext(ctx, sqlStmt, requirementID, programID)
+	if err != nil {
+		return err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrProgramRequirementNotFound
+	}
+
+	return tx.Commit()
+}
+
+// lockProgram locks the program while its requirements change and returns its id
+func lockProgram(ctx context.Context, tx *sql.Tx, id string) (int, error) {
+	programID := 0
+	err := tx.QueryRowContext(ctx, `SELECT id FROM programs WHERE id=$1 FOR UPDATE`, id).Scan(&programID)
+	if err == sql.ErrNoRows {
+		return 0, models.ErrProgramNotFound
+	}
+	return programID, err
+}
+
+// setRequirementCourses adds the courses to the requirement, it returns ErrCourseNotFound when one of them doesn't exist
+func setRequirementCourses(ctx context.Context, tx *sql.Tx, requirementID int, courses []*RequirementCourseEntity) error {
+	courseIDs := make([]int, 0, len(courses))
+	for _, course := range courses {
+		courseIDs = append(courseIDs, course.ID)
+	}
+
+	missing := false
+	sqlStmt := `SELECT EXISTS(SELECT 1 FROM unnest($1::int[]) AS r(id) WHERE NOT EXISTS(SELECT 1 FROM courses c WHERE c.id = r.id))`
+	if err := tx.QueryRowContext(ctx, sqlStmt, pq.Array(courseIDs)).Scan(&missing); err != nil {
+		return err
+	}
+	if missing {
+		return models.ErrCourseNotFound
+	}
+
+	sqlStmt = `INSERT INTO program_requirement_courses(requirement_id, course_id)
+		SELECT $1, id FROM unnest($2::int[]) AS r(id) ON CONFLICT DO NOTHING`
+	_, err := tx.ExecContext(ctx, sqlStmt, requirementID, pq.Array(courseIDs))
+	return err
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/testhelpers"
+	"student_rest/utils"
+	"testing"
+)
+
+func Test_CreateProgramRequirement(t *testing.T) {
+	credits := 6
+
+	testCases := []struct {
+		name          string
+		inputID       string
+		input         *ProgramRequirementEntity
+		expectedError error
+	}{
+		{
+			name:          "program not found",
+			inputID:       "9",
+			input:         &ProgramRequirementEntity{Name: "Core", Kind: models.RequirementKindCourses, Courses: []*RequirementCourseEntity{{ID: 1}}},
+			expectedError: models.ErrProgramNotFound,
+		},
+		{
+			name:          "course not found",
+			inputID:       "1",
+			input:         &ProgramRequirementEntity{Name: "Core", Kind: models.RequirementKindCourses, Courses: []*RequirementCourseEntity{{ID: 1}, {ID: 9}}},
+			expectedError: models.ErrCourseNotFound,
+		},
+		{
+			name:          "create requirement successfully",
+			inputID:       "1",
+			input:         &ProgramRequirementEntity{Name: "Electives", Kind: models.RequirementKindCredits, Credits: &credits, Courses: []*RequirementCourseEntity{{ID: 1}, {ID: 2}}},
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/program/program.sql")
+
+			programRepo := Program{
+				Db: dbMock,
+			}
+
+			result, err := programRepo.CreateProgramRequirement(testCase.inputID, testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, 2, result.ID)
+				require.Equal(t, 1, result.ProgramID)
+				require.Equal(t, []*RequirementCourseEntity{
+					{ID: 1, Name: "Mechanics", Credits: 4},
+					{ID: 2, Name: "Optics", Credits: 3},
+				}, result.Courses)
+			}
+		})
+	}
+}
+
+func Test_DeleteProgram(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		expectedError error
+	}{
+		{
+			name:          "program not found",
+			inputID:       "9",
+			expectedError: models.ErrProgramNotFound,
+		},
+		{
+			name:          "program has students",
+			inputID:       "1",
+			expectedError: models.ErrProgramHasStudents,
+		},
+		{
+			name:          "delete program with its requirements successfully",
+			inputID:       "2",
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/program/program.sql")
+
+			programRepo := Program{
+				Db: dbMock,
+			}
+
+			err := programRepo.DeleteProgram(testCase.inputID)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"student_rest/models"
+
+	"github.com/lib/pq"
+)
+
+type Room struct {
+	Db *sql.DB
+}
+
+type RoomRepositories interface {
+	CreateRoom(room *RoomEntity) (*RoomEntity, error)
+	GetRooms() ([]*RoomEntity, error)
+	GetRoomByID(id string) (*RoomEntity, error)
+	UpdateRoom(id string, room *RoomEntity) error
+	DeleteRoom(id string) error
+	GetFreeRooms(filter *models.RoomFilterModel) ([]*RoomEntity, error)
+	GetRoomBookings(roomID int, from string, to string) ([]*RoomBookingEntity, error)
+	GetCourseRooms(courseID int) ([]*CourseRoomEntity, error)
+	GetRoomCourses(id string) ([]*models.CourseModel, error)
+	BookRoom(courseID int, roomID int, date *string) error
+	DeleteRoomBooking(courseID int, date *string) error
+}
+
+// roomBookingsSQL lists when every room is taken: each session is in the room booked for its date, or else in the
+// room booked for its course, and a course without sessions takes the room booked for it over its whole time span
+const roomBookingsSQL = `SELECT COALESCE(sb.room_id, cb.room_id) AS room_id, c.id AS course_id, c.name AS course_name,
+		s.session_date, s.start_time, s.end_time
+	FROM course_sessions s
+	JOIN courses c ON c.id = s.course_id
+	LEFT JOIN room_bookings cb ON cb.course_id = s.course_id AND cb.session_date IS NULL
+	LEFT JOIN room_bookings sb ON sb.course_id = s.course_id AND sb.session_date = s.session_date
+	WHERE cb.id IS NOT NULL OR sb.id IS NOT NULL
+	UNION ALL
+	SELECT cb.room_id, c.id, c.name, NULL, c.start_time, c.end_time
+	FROM room_bookings cb
+	JOIN courses c ON c.id = cb.course_id
+	WHERE cb.session_date IS NULL AND NOT EXISTS(SELECT 1 FROM course_sessions s WHERE s.course_id = c.id)`
+
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code.Name() == "unique_violation"
+}
+
+func (_self Room) CreateRoom(room *RoomEntity) (*RoomEntity, error) {
+	sqlStmt := `INSERT INTO rooms("building", "number", "capacity", "features") VALUES ($1, $2, $3, $4) RETURNING id`
+	err := _self.Db.QueryRow(sqlStmt, room.Building, room.Number, room.Capacity, pq.Array(room.Features)).Scan(&room.ID)
+	if isUniqueViolation(err) {
+		return nil, models.ErrRoomExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+func (_self Room) GetRooms() ([]*RoomEntity, error) {
+	sqlStmt := `SELECT id, building, number, capacity, features FROM rooms ORDER BY building, number`
+	rows, err := _self.Db.Query(sqlStmt)
+	if err != nil {
+		return nil, err
+	}
+	return scanRooms(rows)
+}
+
+func scanRooms(rows *sql.Rows) ([]*RoomEntity, error) {
+	defer rows.Close()
+
+	rooms := []*RoomEntity{}
+	for rows.Next() {
+		var room RoomEntity
+		if err := rows.Scan(&room.ID, &room.Building, &room.Number, &room.Capacity, pq.Array(&room.Features)); err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, &room)
+	}
+	return rooms, rows.Err()
+}
+
+func (_self Room) GetRoomByID(id string) (*RoomEntity, error) {
+	sqlStmt := `SELECT id, building, number, capacity, features FROM rooms WHERE id=$1`
+	var room RoomEntity
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&room.ID, &room.Building, &room.Number, &room.Capacity, pq.Array(&room.Features))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
+
+func (_self Room) UpdateRoom(id string, room *RoomEntity) error {
+	sqlStmt := `UPDATE rooms SET "building" = $2, "number" = $3, "capacity" = $4, "features" = $5 WHERE id=$1 RETURNING id`
+	roomID := 0
+	err := _self.Db.QueryRow(sqlStmt, id, room.Building, room.Number, room.Capacity, pq.Array(room.Features)).Scan(&roomID)
+	if err == sql.ErrNoRows {
+		return models.ErrRoomNotFound
+	}
+	if isUniqueViolation(err) {
+		return models.ErrRoomExists
+	}
+	return err
+}
+
+// DeleteRoom deletes the room, a room that is still booked can't be deleted
+func (_self Room) DeleteRoom(id string) error {
+	sqlStmt := `DELETE FROM rooms WHERE id=$1 AND NOT EXISTS(SELECT 1 FROM room_bookings WHERE room_id=$1)`
+	result, err := _self.Db.Exec(sqlStmt, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		return nil
+	}
+
+	if _, err = _self.GetRoomByID(id); err != nil {
+		return err
+	}
+	return models.ErrRoomHasBookings
+}
+
+// GetFreeRooms lists the rooms matching the filter that nothing takes between From and To
+func (_self Room) GetFreeRooms(filter *models.RoomFilterModel) ([]*RoomEntity, error) {
+	args := []interface{}{filter.From, filter.To}
+	conditions := ""
+	if filter.MinCapacity > 0 {
+		args = append(args, filter.MinCapacity)
+		conditions += fmt.Sprintf(" AND r.capacity >= $%d", len(args))
+	}
+	if len(filter.Features) > 0 {
+		args = append(args, pq.Array(filter.Features))
+		conditions += fmt.Sprintf(" AND r.features @> $%d", len(args))
+	}
+
+	sqlStmt := `SELECT r.id, r.building, r.number, r.capacity, r.features FROM rooms r
+		WHERE NOT EXISTS(
+			SELECT 1 FROM (` + roomBookingsSQL + `) b
+			WHERE b.room_id = r.id AND b.start_time < $2::timestamp AND b.end_time > $1::timestamp)` + conditions + `
+		ORDER BY r.capacity, r.building, r.number`
+	rows, err := _self.Db.Query(sqlStmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanRooms(rows)
+}
+
+// GetRoomBookings lists the times the room is taken between from and to
+func (_self Room) GetRoomBookings(roomID int, from string, to string) ([]*RoomBookingEntity, error) {
+	sqlStmt := `SELECT room_id, course_id, course_name, to_char(session_date, 'YYYY-MM-DD'), start_time, end_time
+		FROM (` + roomBookingsSQL + `) b
+		WHERE room_id = $1 AND start_time < $3::timestamp AND end_time > $2::timestamp
+		ORDER BY start_time, course_id`
+	rows, err := _self.Db.Query(sqlStmt, roomID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bookings := []*RoomBookingEntity{}
+	for rows.Next() {
+		var booking RoomBookingEntity
+		err = rows.Scan(&booking.RoomID, &booking.CourseID, &booking.CourseName, &booking.Date, &booking.StartTime, &booking.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, &booking)
+	}
+	return bookings, rows.Err()
+}
+
+// GetCourseRooms lists the rooms booked by the course, the room of the whole course first and then the sessions by date
+func (_self Room) GetCourseRooms(courseID int) ([]*CourseRoomEntity, error) {
+	sqlStmt := `SELECT b.course_id, to_char(b.session_date, 'YYYY-MM-DD'), r.id, r.building, r.number, r.capacity, r.features
+		FROM room_bookings b
+		JOIN rooms r ON r.id = b.room_id
+		WHERE b.course_id=$1
+		ORDER BY b.session_date NULLS FIRST`
+	rows, err := _self.Db.Query(sqlStmt, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bookings := []*CourseRoomEntity{}
+	for rows.Next() {
+		booking := CourseRoomEntity{Room: &RoomEntity{}}
+		err = rows.Scan(&booking.CourseID, &booking.Date, &booking.Room.ID, &booking.Room.Building, &booking.Room.Number,
+			&booking.Room.Capacity, pq.Array(&booking.Room.Features))
+		if err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, &booking)
+	}
+	return bookings, rows.Err()
+}
+
+// GetRoomCourses lists the courses that booked the room for the whole course or for one of their sessions
+func (_self Room) GetRoomCourses(id string) ([]*models.CourseModel, error) {
+	sqlStmt := `SELECT DISTINCT c.id, c.name, c.capacity
+		FROM room_bookings b
+		JOIN courses c ON c.id = b.course_id
+		WHERE b.room_id=$1
+		ORDER BY c.id`
+	rows, err := _self.Db.Query(sqlStmt, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	courses := []*models.CourseModel{}
+	for rows.Next() {
+		course := models.CourseModel{}
+		if err = rows.Scan(&course.ID, &course.Name, &course.Capacity); err != nil {
+			return nil, err
+		}
+		courses = append(courses, &course)
+	}
+	return courses, rows.Err()
+}
+
+// BookRoom books the room for the whole course, or only for its session on date. It replaces the room booked before.
+func (_self Room) BookRoom(courseID int, roomID int, date *string) error {
+	sqlStmt := `INSERT INTO room_bookings(room_id, course_id) VALUES ($1, $2)
+		ON CONFLICT (course_id) WHERE session_date IS NULL DO UPDATE SET room_id = EXCLUDED.room_id, booked_at = now()`
+	args := []interface{}{roomID, courseID}
+	if date != nil {
+		sqlStmt = `INSERT INTO room_bookings(room_id, course_id, session_date) VALUES ($1, $2, $3)
+			ON CONFLICT (course_id, session_date) WHERE session_date IS NOT NULL
+			DO UPDATE SET room_id = EXCLUDED.room_id, booked_at = now()`
+		args = append(args, *date)
+	}
+	_, err := _self.Db.Exec(sqlStmt, args...)
+	return err
+}
+
+// DeleteRoomBooking cancels the room booked for the whole course, or only the one booked for its session on date
+func (_self Room) DeleteRoomBooking(courseID int, date *string) error {
+	sqlStmt := `DELETE FROM room_bookings WHERE course_id=$1 AND session_date IS NOT DISTINCT FROM $2::date`
+	result, err := _self.Db.Exec(sqlStmt, courseID, date)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrRoomBookingNotFound
+	}
+	return nil
+}
+
+// deleteUnscheduledRoomBookings cancels the rooms booked for sessions the course doesn't have anymore
+func deleteUnscheduledRoomBookings(ctx context.Context, tx *sql.Tx, courseID interface{}) error {
+	sqlStmt := `DELETE FROM room_bookings b WHERE b.course_id=$1 AND b.session_date IS NOT NULL
+		AND NOT EXISTS(SELECT 1 FROM course_sessions s WHERE s.course_id = b.course_id AND s.session_date = b.session_date)`
+	_, err := tx.ExecContext(ctx, sqlStmt, courseID)
+	return err
+}
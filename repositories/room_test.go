@@ -0,0 +1,219 @@
+package repositories
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/testhelpers"
+	"student_rest/utils"
+	"testing"
+)
+
+func Test_CreateRoom(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         *RoomEntity
+		expectedError error
+	}{
+		{
+			name:          "room already exists",
+			input:         &RoomEntity{Building: "A", Number: "101", Capacity: 20, Features: []string{}},
+			expectedError: models.ErrRoomExists,
+		},
+		{
+			name:          "create room successfully",
+			input:         &RoomEntity{Building: "A", Number: "102", Capacity: 20, Features: []string{"projector"}},
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/room/room.sql")
+
+			roomRepo := Room{
+				Db: dbMock,
+			}
+
+			result, err := roomRepo.CreateRoom(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, 5, result.ID)
+			}
+		})
+	}
+}
+
+func Test_DeleteRoom(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		expectedError error
+	}{
+		{
+			name:          "room not found",
+			inputID:       "9",
+			expectedError: models.ErrRoomNotFound,
+		},
+		{
+			name:          "room is booked",
+			inputID:       "1",
+			expectedError: models.ErrRoomHasBookings,
+		},
+		{
+			name:          "delete room successfully",
+			inputID:       "4",
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/room/room.sql")
+
+			roomRepo := Room{
+				Db: dbMock,
+			}
+
+			err := roomRepo.DeleteRoom(testCase.inputID)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_GetRoomCourses(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		expectedValue []*models.CourseModel
+	}{
+		{
+			name:          "room booked for a session",
+			inputID:       "2",
+			expectedValue: []*models.CourseModel{{ID: 1, Name: "Math"}},
+		},
+		{
+			name:          "room booked for a course",
+			inputID:       "3",
+			expectedValue: []*models.CourseModel{{ID: 2, Name: "Physics"}},
+		},
+		{
+			name:          "room without bookings",
+			inputID:       "4",
+			expectedValue: []*models.CourseModel{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/room/room.sql")
+
+			roomRepo := Room{
+				Db: dbMock,
+			}
+
+			result, err := roomRepo.GetRoomCourses(testCase.inputID)
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedValue, result)
+		})
+	}
+}
+
+func Test_GetFreeRooms(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       *models.RoomFilterModel
+		expectedIDs []int
+	}{
+		{
+			name:        "room of the course is taken by its session",
+			input:       &models.RoomFilterModel{From: "2020-11-02T09:30:00Z", To: "2020-11-02T10:00:00Z"},
+			expectedIDs: []int{4, 3, 2},
+		},
+		{
+			name:        "session moved to another room",
+			input:       &models.RoomFilterModel{From: "2020-11-09T09:30:00Z", To: "2020-11-09T10:00:00Z"},
+			expectedIDs: []int{4, 3, 1},
+		},
+		{
+			name:        "course without sessions takes its room for its whole time span",
+			input:       &models.RoomFilterModel{From: "2020-11-04T12:00:00Z", To: "2020-11-04T13:00:00Z"},
+			expectedIDs: []int{4, 1, 2},
+		},
+		{
+			name: "rooms with enough seats and features",
+			input: &models.RoomFilterModel{
+				From:        "2020-11-03T09:00:00Z",
+				To:          "2020-11-03T10:00:00Z",
+				MinCapacity: 20,
+				Features:    []string{"projector"},
+			},
+			expectedIDs: []int{1, 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/room/room.sql")
+
+			roomRepo := Room{
+				Db: dbMock,
+			}
+
+			result, err := roomRepo.GetFreeRooms(testCase.input)
+
+			require.NoError(t, err)
+			ids := []int{}
+			for _, room := range result {
+				ids = append(ids, room.ID)
+			}
+			require.Equal(t, testCase.expectedIDs, ids)
+		})
+	}
+}
+
+func Test_BookRoom(t *testing.T) {
+	dbMock, _ := testhelpers.ConnectDB()
+
+	utils.LoadFixture(dbMock, "./testdata/room/room.sql")
+
+	roomRepo := Room{
+		Db: dbMock,
+	}
+
+	// Booking again replaces the room of the session
+	sessionDate := "2020-11-09"
+	require.NoError(t, roomRepo.BookRoom(1, 4, &sessionDate))
+
+	result, err := roomRepo.GetRoomBookings(4, "2020-11-01T00:00:00Z", "2020-11-30T00:00:00Z")
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, 1, result[0].CourseID)
+	require.Equal(t, &sessionDate, result[0].Date)
+
+	result, err = roomRepo.GetRoomBookings(2, "2020-11-01T00:00:00Z", "2020-11-30T00:00:00Z")
+
+	require.NoError(t, err)
+	require.Len(t, result, 0)
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"student_rest/models"
 )
 
@@ -13,15 +14,26 @@ type Student struct{
 type StudentRepositories interface {
 	CreateStudent(student *StudentEntity) (*StudentEntity, error)
 	GetStudentByID(id string) (*StudentEntity, error)
+	GetStudentByCode(code string) (*StudentEntity, error)
 	DeleteStudent(id string) error
 	UpdateStudent(id string, student *StudentEntity) error
 	RegisterCourse(registerCourseModel *models.RegisterCourseModel) (*models.RegisterCourseModel, error)
+	EnrollCourse(studentID string, courseID string, options *models.EnrollmentOptionsModel, maxCreditLoad int) (*EnrollmentEntity, error)
+	WithdrawCourse(studentID string, courseID string, withdrawal *models.WithdrawCourseModel, maxCreditLoad int) (*EnrollmentEntity, error)
+	GetStudentCourses(studentID string, filter *models.StudentCourseFilterModel) ([]*models.CourseModel, error)
+	RecordGrade(studentID string, courseID string, grade *models.RecordGradeModel) (*EnrollmentEntity, error)
+	GetStudentGrades(studentID string) ([]*models.GradeModel, error)
+	GetTranscriptCourses(studentID string) ([]*TranscriptCourseEntity, error)
+	GetCourseHistory(studentID string) ([]*CourseHistoryEntity, error)
+	SetCreditOverride(studentID string, override *models.CreditOverrideModel) error
+	DeleteCreditOverride(studentID string) error
+	GetCreditOverride(studentID string) (*models.CreditOverrideModel, error)
 }
 
 func (_self Student) CreateStudent(student *StudentEntity) (*StudentEntity, error) {
-	sqlStmt := `INSERT INTO students("student_id", "first_name", "last_name", "date_of_birth") VALUES ($1, $2, $3, $4) RETURNING id`
+	sqlStmt := `INSERT INTO students("student_id", "first_name", "last_name", "date_of_birth", "program_id") VALUES ($1, $2, $3, $4, $5) RETURNING id`
 	id := 0
-	err := _self.Db.QueryRow(sqlStmt, student.StudentID, student.FirstName, student.LastName, student.DateOfBirth).Scan(&id)
+	err := _self.Db.QueryRow(sqlStmt, student.StudentID, student.FirstName, student.LastName, student.DateOfBirth, student.ProgramID).Scan(&id)
 	if err != nil {
 		return nil, err
 	}
@@ -30,9 +42,24 @@ func (_self Student) CreateStudent(student *StudentEntity) (*StudentEntity, erro
 }
 
 func (_self Student) GetStudentByID(id string) (*StudentEntity, error) {
-	sqlStmt := `SELECT * FROM students WHERE id=$1`
+	sqlStmt := `SELECT id, student_id, first_name, last_name, date_of_birth, program_id FROM students WHERE id=$1`
 	var student StudentEntity
-	err := _self.Db.QueryRow(sqlStmt, id).Scan(&student.ID, &student.StudentID, &student.FirstName, &student.LastName, &student.DateOfBirth)
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&student.ID, &student.StudentID, &student.FirstName, &student.LastName, &student.DateOfBirth,
+		&student.ProgramID)
+	if err != nil {
+		return nil, err
+	}
+	return &student, nil
+}
+
+func (_self Student) GetStudentByCode(code string) (*StudentEntity, error) {
+	sqlStmt := `SELECT id, student_id, first_name, last_name, date_of_birth, program_id FROM students WHERE student_id=$1`
+	var student StudentEntity
+	err := _self.Db.QueryRow(sqlStmt, code).Scan(&student.ID, &student.StudentID, &student.FirstName, &student.LastName, &student.DateOfBirth,
+		&student.ProgramID)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrStudentNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -46,8 +73,8 @@ func (_self Student) DeleteStudent(id string) error {
 }
 
 func (_self Student) UpdateStudent(id string, student *StudentEntity) error {
-	sqlStmt := `UPDATE students SET "first_name" = $2, "last_name" = $3, "date_of_birth" = $4 WHERE id=$1`
-	_, err := _self.Db.Exec(sqlStmt, id, student.FirstName, student.LastName, student.DateOfBirth)
+	sqlStmt := `UPDATE students SET "first_name" = $2, "last_name" = $3, "date_of_birth" = $4, "program_id" = $5 WHERE id=$1`
+	_, err := _self.Db.Exec(sqlStmt, id, student.FirstName, student.LastName, student.DateOfBirth, student.ProgramID)
 	return err
 }
 
@@ -87,23 +114,31 @@ func (_self Student) RegisterCourse(registerCourseModel *models.RegisterCourseMo
 		return nil, err
 	}
 
-	sqlStmt = `INSERT INTO courses(name, start_time, end_time, teacher_id) VALUES ($1, $2, $3, $4) RETURNING id`
+	sqlStmt = `INSERT INTO courses(name, start_time, end_time, credits, registration_opens_at, registration_closes_at, term_id, teacher_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
 
-	err = tx.QueryRowContext(ctx, sqlStmt, course.Name, course.StartTime, course.EndTime, course.Teacher.ID).
+	err = tx.QueryRowContext(ctx, sqlStmt, course.Name, course.StartTime, course.EndTime, course.Credits,
+		course.RegistrationOpensAt, course.RegistrationClosesAt, course.Term.ID, course.Teacher.ID).
 		Scan(&course.ID)
 
 	if err != nil {
 		return nil, err
 	}
 
-	sqlStmt = `INSERT INTO students_courses(student_id, course_id) VALUES ($1, $2)`
+	sqlStmt = `INSERT INTO students_courses(student_id, course_id) VALUES ($1, $2) RETURNING id, status`
 
-	_, err = tx.ExecContext(ctx, sqlStmt, newStudent.ID, course.ID)
+	enrollmentID := 0
+	status := ""
+	err = tx.QueryRowContext(ctx, sqlStmt, newStudent.ID, course.ID).Scan(&enrollmentID, &status)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if err = recordStatusChange(ctx, tx, enrollmentID, "", status, "", ""); err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 
 	if err != nil {
@@ -115,3 +150,318 @@ func (_self Student) RegisterCourse(registerCourseModel *models.RegisterCourseMo
 		Course:  course,
 	}, nil
 }
+
+// EnrollCourse enrolls the student in the course, or puts them on the course waitlist when it is full.
+// It returns a CreditLimitExceededError when the course takes the student over their limit or else maxCreditLoad.
+func (_self Student) EnrollCourse(studentID string, courseID string, options *models.EnrollmentOptionsModel, maxCreditLoad int) (*EnrollmentEntity, error) {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	course, err := lockCourse(ctx, tx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedStudentID, err := lockStudent(ctx, tx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := enroll(ctx, tx, lockedStudentID, course, options, maxCreditLoad)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return enrollment, nil
+}
+
+// WithdrawCourse marks the enrollment or waitlist entry as withdrawn, the row is kept for history.
+// A seat freed by an active student goes to the first student on the waitlist within maxCreditLoad.
+func (_self Student) WithdrawCourse(studentID string, courseID string, withdrawal *models.WithdrawCourseModel, maxCreditLoad int) (*EnrollmentEntity, error) {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	sqlStmt := `SELECT id FROM courses WHERE id=$1 FOR UPDATE`
+	lockedCourseID := 0
+	err = tx.QueryRowContext(ctx, sqlStmt, courseID).Scan(&lockedCourseID)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrNotEnrolled
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `SELECT id, status FROM students_courses WHERE student_id=$1 AND course_id=$2 AND status IN ($3, $4) FOR UPDATE`
+	enrollmentID := 0
+	previousStatus := ""
+	err = tx.QueryRowContext(ctx, sqlStmt, studentID, lockedCourseID,
+		models.EnrollmentStatusActive, models.EnrollmentStatusWaitlisted).Scan(&enrollmentID, &previousStatus)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrNotEnrolled
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `UPDATE students_courses SET "status" = $2, "withdrawal_reason" = $3, "withdrawn_at" = $4, "withdrawn_by" = $5
+		WHERE id=$1
+		RETURNING id, student_id, course_id, status, withdrawal_reason, withdrawn_at, withdrawn_by`
+	var enrollment EnrollmentEntity
+	err = tx.QueryRowContext(ctx, sqlStmt, enrollmentID, models.EnrollmentStatusWithdrawn, withdrawal.Reason,
+		withdrawal.EffectiveDate, withdrawal.PerformedBy).
+		Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.Status,
+			&enrollment.WithdrawalReason, &enrollment.WithdrawnAt, &enrollment.WithdrawnBy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = recordStatusChange(ctx, tx, enrollment.ID, previousStatus, enrollment.Status, withdrawal.Reason, withdrawal.PerformedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if previousStatus == models.EnrollmentStatusActive {
+		if err = promoteWaitlisted(ctx, tx, lockedCourseID, maxCreditLoad); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &enrollment, nil
+}
+
+// GetStudentCourses lists the courses of the student in the status of filter that run at some point between its from
+// and to dates, both inclusive
+func (_self Student) GetStudentCourses(studentID string, filter *models.StudentCourseFilterModel) ([]*models.CourseModel, error) {
+	sqlStmt := `SELECT id FROM students WHERE id=$1`
+	id := 0
+	err := _self.Db.QueryRow(sqlStmt, studentID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `SELECT c.id, c.name, c.start_time, c.end_time, c.credits,
+			tm.id, tm.name, tm.start_date, tm.end_date, tm.status, t.id, t.first_name, t.last_name, t.date_of_birth
+		FROM students_courses sc
+		JOIN courses c ON c.id = sc.course_id
+		JOIN terms tm ON tm.id = c.term_id
+		JOIN teachers t ON t.id = c.teacher_id
+		WHERE sc.student_id = $1`
+	args := []interface{}{id}
+	if filter.From != "" {
+		args = append(args, filter.From)
+		sqlStmt += fmt.Sprintf(` AND c.end_time > $%d::date`, len(args))
+	}
+	if filter.To != "" {
+		args = append(args, filter.To)
+		sqlStmt += fmt.Sprintf(` AND c.start_time < $%d::date + 1`, len(args))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		sqlStmt += fmt.Sprintf(` AND sc.status = $%d`, len(args))
+	}
+	sqlStmt += ` ORDER BY c.start_time, c.id`
+
+	rows, err := _self.Db.Query(sqlStmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	courses := []*models.CourseModel{}
+	for rows.Next() {
+		course := models.CourseModel{Term: &models.TermModel{}, Teacher: &models.TeacherModel{}}
+		err = rows.Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime, &course.Credits,
+			&course.Term.ID, &course.Term.Name, &course.Term.StartDate, &course.Term.EndDate, &course.Term.Status,
+			&course.Teacher.ID, &course.Teacher.FirstName, &course.Teacher.LastName, &course.Teacher.DateOfBirth)
+		if err != nil {
+			return nil, err
+		}
+		courses = append(courses, &course)
+	}
+	return courses, rows.Err()
+}
+
+// RecordGrade sets the grade of the student's latest enrollment in the course that can be graded
+func (_self Student) RecordGrade(studentID string, courseID string, grade *models.RecordGradeModel) (*EnrollmentEntity, error) {
+	sqlStmt := `UPDATE students_courses SET "grade_letter" = $3, "grade_score" = $4, "graded_at" = now()
+		WHERE id = (
+			SELECT id FROM students_courses
+			WHERE student_id=$1 AND course_id=$2 AND status IN ($5, $6, $7)
+			ORDER BY id DESC LIMIT 1
+		)
+		RETURNING id, student_id, course_id, status, grade_letter, grade_score, graded_at`
+	var enrollment EnrollmentEntity
+	err := _self.Db.QueryRow(sqlStmt, studentID, courseID, grade.Letter, grade.Score,
+		models.EnrollmentStatusActive, models.EnrollmentStatusCompleted, models.EnrollmentStatusFailed).
+		Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.Status,
+			&enrollment.GradeLetter, &enrollment.GradeScore, &enrollment.GradedAt)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrNotEnrolled
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &enrollment, nil
+}
+
+// GetStudentGrades returns the graded enrollments of the student ordered by course start time
+func (_self Student) GetStudentGrades(studentID string) ([]*models.GradeModel, error) {
+	sqlStmt := `SELECT id FROM students WHERE id=$1`
+	id := 0
+	err := _self.Db.QueryRow(sqlStmt, studentID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStmt = `SELECT sc.id, c.id, c.name, c.credits, tm.name, c.start_time, sc.grade_letter, sc.grade_score
+		FROM students_courses sc
+		JOIN courses c ON c.id = sc.course_id
+		JOIN terms tm ON tm.id = c.term_id
+		WHERE sc.student_id = $1 AND (sc.grade_letter IS NOT NULL OR sc.grade_score IS NOT NULL)
+		ORDER BY tm.start_date, tm.id, c.start_time, c.id`
+	rows, err := _self.Db.Query(sqlStmt, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	grades := []*models.GradeModel{}
+	for rows.Next() {
+		var grade models.GradeModel
+		err = rows.Scan(&grade.EnrollmentID, &grade.CourseID, &grade.CourseName, &grade.Credits, &grade.Term, &grade.StartTime,
+			&grade.Letter, &grade.Score)
+		if err != nil {
+			return nil, err
+		}
+		grades = append(grades, &grade)
+	}
+	return grades, rows.Err()
+}
+
+// GetTranscriptCourses returns every enrollment the student held or dropped, waitlisted ones are left out
+func (_self Student) GetTranscriptCourses(studentID string) ([]*TranscriptCourseEntity, error) {
+	sqlStmt := `SELECT sc.id, c.id, c.name, c.credits, tm.name, c.start_time, c.end_time, sc.status, sc.grade_letter, sc.grade_score
+		FROM students_courses sc
+		JOIN courses c ON c.id = sc.course_id
+		JOIN terms tm ON tm.id = c.term_id
+		WHERE sc.student_id = $1 AND sc.status <> $2
+		ORDER BY tm.start_date, tm.id, c.start_time, c.id`
+	rows, err := _self.Db.Query(sqlStmt, studentID, models.EnrollmentStatusWaitlisted)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	courses := []*TranscriptCourseEntity{}
+	for rows.Next() {
+		var course TranscriptCourseEntity
+		err = rows.Scan(&course.EnrollmentID, &course.CourseID, &course.CourseName, &course.Credits, &course.Term, &course.StartTime, &course.EndTime,
+			&course.Status, &course.GradeLetter, &course.GradeScore)
+		if err != nil {
+			return nil, err
+		}
+		courses = append(courses, &course)
+	}
+	return courses, rows.Err()
+}
+
+// GetCourseHistory returns every enrollment of the student with the credits of its course, in the order of the terms
+func (_self Student) GetCourseHistory(studentID string) ([]*CourseHistoryEntity, error) {
+	sqlStmt := `SELECT c.id, c.name, c.credits, sc.status, sc.grade_letter, sc.grade_score
+		FROM students_courses sc
+		JOIN courses c ON c.id = sc.course_id
+		JOIN terms tm ON tm.id = c.term_id
+		WHERE sc.student_id = $1
+		ORDER BY tm.start_date, tm.id, c.start_time, c.id`
+	rows, err := _self.Db.Query(sqlStmt, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []*CourseHistoryEntity{}
+	for rows.Next() {
+		var course CourseHistoryEntity
+		err = rows.Scan(&course.CourseID, &course.CourseName, &course.Credits, &course.Status, &course.GradeLetter, &course.GradeScore)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, &course)
+	}
+	return history, rows.Err()
+}
+
+// SetCreditOverride stores an approved overload for the student, replacing the previous one
+func (_self Student) SetCreditOverride(studentID string, override *models.CreditOverrideModel) error {
+	sqlStmt := `SELECT id FROM students WHERE id=$1`
+	id := 0
+	err := _self.Db.QueryRow(sqlStmt, studentID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return models.ErrStudentNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	sqlStmt = `INSERT INTO student_credit_overrides(student_id, max_credits, approved_by) VALUES ($1, $2, $3)
+		ON CONFLICT (student_id) DO UPDATE SET max_credits = EXCLUDED.max_credits, approved_by = EXCLUDED.approved_by, approved_at = now()`
+	_, err = _self.Db.Exec(sqlStmt, id, override.MaxCredits, override.ApprovedBy)
+	return err
+}
+
+func (_self Student) DeleteCreditOverride(studentID string) error {
+	sqlStmt := `DELETE FROM student_credit_overrides WHERE student_id=$1`
+	result, err := _self.Db.Exec(sqlStmt, studentID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrCreditOverrideNotFound
+	}
+	return nil
+}
+
+// GetCreditOverride returns the approved overload of the student, or nil when there is none
+func (_self Student) GetCreditOverride(studentID string) (*models.CreditOverrideModel, error) {
+	sqlStmt := `SELECT max_credits, approved_by FROM student_credit_overrides WHERE student_id=$1`
+	var override models.CreditOverrideModel
+	err := _self.Db.QueryRow(sqlStmt, studentID).Scan(&override.MaxCredits, &override.ApprovedBy)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &override, nil
+}
@@ -137,6 +137,60 @@ func Test_GetStudentByID(t *testing.T) {
 	}
 }
 
+func Test_GetStudentByCode(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedValue *StudentEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "student not found",
+			input:         "999999",
+			expectedValue: nil,
+			expectedError: models.ErrStudentNotFound,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:  "get student by code successfully",
+			input: "234567",
+			expectedValue: &StudentEntity{
+				ID:          2,
+				StudentID:   "234567",
+				FirstName:   "Mai",
+				LastName:    "Dao",
+				DateOfBirth: "1998-11-02T00:00:00Z",
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.GetStudentByCode(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
 func Test_DeleteStudent(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -279,6 +333,7 @@ func Test_RegisterCourse(t *testing.T) {
 					DateOfBirth: "6/25/1997",
 				},
 				Course: &models.CourseModel{
+					Term:    &models.TermModel{ID: 1},
 					Teacher: &models.TeacherModel{},
 				},
 			},
@@ -296,6 +351,7 @@ func Test_RegisterCourse(t *testing.T) {
 					DateOfBirth: "6/25/1997",
 				},
 				Course: &models.CourseModel{
+					Term: &models.TermModel{ID: 1},
 					Teacher: &models.TeacherModel{
 						FirstName:   "Dao",
 						LastName:    "Mai",
@@ -320,6 +376,7 @@ func Test_RegisterCourse(t *testing.T) {
 					Name:      "Math",
 					StartTime: "4/23/2020",
 					EndTime:   "4/24/2020",
+					Term:      &models.TermModel{ID: 1},
 					Teacher: &models.TeacherModel{
 						FirstName:   "Dao",
 						LastName:    "Mai",
@@ -346,7 +403,7 @@ func Test_RegisterCourse(t *testing.T) {
 				},
 			},
 			expectedError: nil,
-			giveFixture:   "./testdata/truncate_data.sql",
+			giveFixture:   "./testdata/term/term.sql",
 		},
 	}
 
@@ -393,7 +450,7 @@ func Test_RegisterCourse(t *testing.T) {
 				require.Equal(t, testCase.expectedValue.Course.Teacher.LastName, teacher.LastName)
 				require.Equal(t, testCase.expectedValue.Course.Teacher.DateOfBirth, teacher.DateOfBirth)
 
-				sqlStmt = `SELECT * FROM courses WHERE id=$1`
+				sqlStmt = `SELECT id, name, start_time, end_time, teacher_id FROM courses WHERE id=$1`
 				var course CourseEntity
 				err = dbMock.QueryRow(sqlStmt, result.Course.ID).Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime, &course.TeacherID)
 				if err != nil {
@@ -407,3 +464,716 @@ func Test_RegisterCourse(t *testing.T) {
 		})
 	}
 }
+
+func Test_EnrollCourse(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputCourseID string
+		expectedValue *EnrollmentEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "student not found",
+			inputID:       "3",
+			inputCourseID: "1",
+			expectedValue: nil,
+			expectedError: models.ErrStudentNotFound,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:          "course not found",
+			inputID:       "2",
+			inputCourseID: "3",
+			expectedValue: nil,
+			expectedError: models.ErrCourseNotFound,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:          "student already enrolled",
+			inputID:       "1",
+			inputCourseID: "1",
+			expectedValue: nil,
+			expectedError: models.ErrAlreadyEnrolled,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:          "enroll course successfully",
+			inputID:       "2",
+			inputCourseID: "1",
+			expectedValue: &EnrollmentEntity{
+				StudentID: 2,
+				CourseID:  1,
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.EnrollCourse(testCase.inputID, testCase.inputCourseID, &models.EnrollmentOptionsModel{}, 0)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+
+				sqlStmt := `SELECT id, student_id, course_id FROM students_courses WHERE id=$1`
+				var enrollment EnrollmentEntity
+				err := dbMock.QueryRow(sqlStmt, result.ID).Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID)
+				if err != nil {
+					t.Error(err)
+				}
+				require.Equal(t, testCase.expectedValue.StudentID, enrollment.StudentID)
+				require.Equal(t, testCase.expectedValue.CourseID, enrollment.CourseID)
+			}
+		})
+	}
+}
+
+func Test_WithdrawCourse(t *testing.T) {
+	testCases := []struct {
+		name            string
+		inputID         string
+		inputCourseID   string
+		inputWithdrawal *models.WithdrawCourseModel
+		expectedValue   *EnrollmentEntity
+		expectedError   error
+		giveFixture     string
+	}{
+		{
+			name:          "student not enrolled",
+			inputID:       "2",
+			inputCourseID: "1",
+			inputWithdrawal: &models.WithdrawCourseModel{
+				Reason:        "Schedule change",
+				EffectiveDate: "11/5/2020",
+				PerformedBy:   "registrar",
+			},
+			expectedValue: nil,
+			expectedError: models.ErrNotEnrolled,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:          "withdraw course successfully",
+			inputID:       "1",
+			inputCourseID: "1",
+			inputWithdrawal: &models.WithdrawCourseModel{
+				Reason:        "Schedule change",
+				EffectiveDate: "11/5/2020",
+				PerformedBy:   "registrar",
+			},
+			expectedValue: &EnrollmentEntity{
+				ID:        1,
+				StudentID: 1,
+				CourseID:  1,
+				Status:    "withdrawn",
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.WithdrawCourse(testCase.inputID, testCase.inputCourseID, testCase.inputWithdrawal, models.DefaultMaxCreditLoad)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue.ID, result.ID)
+				require.Equal(t, testCase.expectedValue.StudentID, result.StudentID)
+				require.Equal(t, testCase.expectedValue.CourseID, result.CourseID)
+				require.Equal(t, testCase.expectedValue.Status, result.Status)
+				require.Equal(t, "Schedule change", *result.WithdrawalReason)
+				require.Equal(t, "2020-11-05T00:00:00Z", *result.WithdrawnAt)
+				require.Equal(t, "registrar", *result.WithdrawnBy)
+			}
+		})
+	}
+}
+
+func Test_GetStudentCourses(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputFilter   *models.StudentCourseFilterModel
+		expectedValue []*models.CourseModel
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "student not found",
+			inputID:       "3",
+			inputFilter:   &models.StudentCourseFilterModel{},
+			expectedValue: nil,
+			expectedError: models.ErrStudentNotFound,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:          "no courses in status",
+			inputID:       "1",
+			inputFilter:   &models.StudentCourseFilterModel{Status: "withdrawn"},
+			expectedValue: []*models.CourseModel{},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:    "get student courses successfully",
+			inputID: "1",
+			inputFilter: &models.StudentCourseFilterModel{
+				From:   "11/1/2020",
+				To:     "11/30/2020",
+				Status: "active",
+			},
+			expectedValue: []*models.CourseModel{
+				{
+					ID:        1,
+					Name:      "Math",
+					StartTime: "2020-11-02T00:00:00Z",
+					EndTime:   "2020-11-03T00:00:00Z",
+					Term: &models.TermModel{
+						ID:        1,
+						Name:      "Fall 2020",
+						StartDate: "2020-08-15T00:00:00Z",
+						EndDate:   "2020-12-31T00:00:00Z",
+						Status:    models.TermStatusActive,
+					},
+					Teacher: &models.TeacherModel{
+						ID:          1,
+						FirstName:   "Anh",
+						LastName:    "Le",
+						DateOfBirth: "1998-11-02T00:00:00Z",
+					},
+				},
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:    "course still running on the last day of the range",
+			inputID: "1",
+			inputFilter: &models.StudentCourseFilterModel{
+				From:   "10/1/2020",
+				To:     "11/2/2020",
+				Status: "active",
+			},
+			expectedValue: []*models.CourseModel{
+				{
+					ID:        1,
+					Name:      "Math",
+					StartTime: "2020-11-02T00:00:00Z",
+					EndTime:   "2020-11-03T00:00:00Z",
+					Term: &models.TermModel{
+						ID:        1,
+						Name:      "Fall 2020",
+						StartDate: "2020-08-15T00:00:00Z",
+						EndDate:   "2020-12-31T00:00:00Z",
+						Status:    models.TermStatusActive,
+					},
+					Teacher: &models.TeacherModel{
+						ID:          1,
+						FirstName:   "Anh",
+						LastName:    "Le",
+						DateOfBirth: "1998-11-02T00:00:00Z",
+					},
+				},
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+		{
+			name:    "course ended before the range",
+			inputID: "1",
+			inputFilter: &models.StudentCourseFilterModel{
+				From:   "11/4/2020",
+				Status: "active",
+			},
+			expectedValue: []*models.CourseModel{},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/student.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.GetStudentCourses(testCase.inputID, testCase.inputFilter)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_EnrollCourseWaitlist(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputCourseID string
+		expectedValue *EnrollmentEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "student already waitlisted",
+			inputID:       "2",
+			inputCourseID: "1",
+			expectedValue: nil,
+			expectedError: models.ErrAlreadyWaitlisted,
+			giveFixture:   "./testdata/student/waitlist.sql",
+		},
+		{
+			name:          "course full and student waitlisted",
+			inputID:       "3",
+			inputCourseID: "1",
+			expectedValue: &EnrollmentEntity{
+				StudentID: 3,
+				CourseID:  1,
+				Status:    "waitlisted",
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/waitlist.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.EnrollCourse(testCase.inputID, testCase.inputCourseID, &models.EnrollmentOptionsModel{}, 0)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue.StudentID, result.StudentID)
+				require.Equal(t, testCase.expectedValue.CourseID, result.CourseID)
+				require.Equal(t, testCase.expectedValue.Status, result.Status)
+				require.Equal(t, 2, *result.WaitlistPosition)
+			}
+		})
+	}
+}
+
+func Test_EnrollCourseCreditLimit(t *testing.T) {
+	testCases := []struct {
+		name               string
+		inputID            string
+		inputMaxCreditLoad int
+		expectedError      error
+	}{
+		{
+			name:               "credit limit exceeded",
+			inputID:            "2",
+			inputMaxCreditLoad: models.DefaultMaxCreditLoad,
+			expectedError:      &models.CreditLimitExceededError{Term: "Fall 2020", CurrentCredits: 16, CourseCredits: 4, Limit: 18},
+		},
+		{
+			name:               "no credit limit",
+			inputID:            "2",
+			inputMaxCreditLoad: 0,
+			expectedError:      nil,
+		},
+		{
+			name:               "enroll within the credit limit",
+			inputID:            "3",
+			inputMaxCreditLoad: models.DefaultMaxCreditLoad,
+			expectedError:      nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, "./testdata/student/waitlist_credits.sql")
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.EnrollCourse(testCase.inputID, "3", &models.EnrollmentOptionsModel{}, testCase.inputMaxCreditLoad)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.Equal(t, testCase.expectedError, err)
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, models.EnrollmentStatusActive, result.Status)
+			}
+		})
+	}
+}
+
+func Test_EnrollCourseRecordsScheduleOverride(t *testing.T) {
+	dbMock, _ := testhelpers.ConnectDB()
+
+	utils.LoadFixture(dbMock, "./testdata/student/student.sql")
+
+	studentRepo := Student{
+		Db: dbMock,
+	}
+
+	result, err := studentRepo.EnrollCourse("2", "1", &models.EnrollmentOptionsModel{
+		OverrideScheduleConflict: true,
+		OverriddenBy:             "registrar",
+	}, 0)
+	require.NoError(t, err)
+
+	sqlStmt := `SELECT reason, changed_by FROM enrollment_status_history WHERE enrollment_id=$1`
+	reason, changedBy := "", ""
+	err = dbMock.QueryRow(sqlStmt, result.ID).Scan(&reason, &changedBy)
+	if err != nil {
+		t.Error(err)
+	}
+	require.Equal(t, "schedule conflict check overridden", reason)
+	require.Equal(t, "registrar", changedBy)
+}
+
+func Test_WithdrawCoursePromotesWaitlist(t *testing.T) {
+	dbMock, _ := testhelpers.ConnectDB()
+
+	utils.LoadFixture(dbMock, "./testdata/student/waitlist.sql")
+
+	studentRepo := Student{
+		Db: dbMock,
+	}
+
+	_, err := studentRepo.WithdrawCourse("1", "1", &models.WithdrawCourseModel{
+		Reason:        "Schedule change",
+		EffectiveDate: "11/5/2020",
+		PerformedBy:   "registrar",
+	}, models.DefaultMaxCreditLoad)
+	require.NoError(t, err)
+
+	sqlStmt := `SELECT status FROM students_courses WHERE id=$1`
+	status := ""
+	err = dbMock.QueryRow(sqlStmt, 2).Scan(&status)
+	if err != nil {
+		t.Error(err)
+	}
+	require.Equal(t, models.EnrollmentStatusActive, status)
+}
+
+func Test_WithdrawCourseSkipsWaitlistedOverCreditLimit(t *testing.T) {
+	dbMock, _ := testhelpers.ConnectDB()
+
+	utils.LoadFixture(dbMock, "./testdata/student/waitlist_credits.sql")
+
+	studentRepo := Student{
+		Db: dbMock,
+	}
+
+	_, err := studentRepo.WithdrawCourse("1", "1", &models.WithdrawCourseModel{
+		Reason:        "Schedule change",
+		EffectiveDate: "11/5/2020",
+		PerformedBy:   "registrar",
+	}, models.DefaultMaxCreditLoad)
+	require.NoError(t, err)
+
+	sqlStmt := `SELECT status FROM students_courses WHERE id=$1`
+	skipped, promoted := "", ""
+	if err = dbMock.QueryRow(sqlStmt, 3).Scan(&skipped); err != nil {
+		t.Error(err)
+	}
+	if err = dbMock.QueryRow(sqlStmt, 4).Scan(&promoted); err != nil {
+		t.Error(err)
+	}
+	require.Equal(t, models.EnrollmentStatusWaitlisted, skipped)
+	require.Equal(t, models.EnrollmentStatusActive, promoted)
+}
+
+func Test_RecordGrade(t *testing.T) {
+	score := 88.0
+
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputCourseID string
+		inputGrade    *models.RecordGradeModel
+		expectedValue *EnrollmentEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "withdrawn enrollment can't be graded",
+			inputID:       "2",
+			inputCourseID: "1",
+			inputGrade:    &models.RecordGradeModel{Score: &score},
+			expectedValue: nil,
+			expectedError: models.ErrNotEnrolled,
+			giveFixture:   "./testdata/student/grades.sql",
+		},
+		{
+			name:          "record grade successfully",
+			inputID:       "1",
+			inputCourseID: "2",
+			inputGrade:    &models.RecordGradeModel{Score: &score},
+			expectedValue: &EnrollmentEntity{
+				ID:         2,
+				StudentID:  1,
+				CourseID:   2,
+				Status:     "active",
+				GradeScore: &score,
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/grades.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.RecordGrade(testCase.inputID, testCase.inputCourseID, testCase.inputGrade)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.NotNil(t, result.GradedAt)
+				result.GradedAt = nil
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetStudentGrades(t *testing.T) {
+	a := "A"
+
+	testCases := []struct {
+		name          string
+		input         string
+		expectedValue []*models.GradeModel
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "student not found",
+			input:         "9",
+			expectedValue: nil,
+			expectedError: models.ErrStudentNotFound,
+			giveFixture:   "./testdata/student/grades.sql",
+		},
+		{
+			name:  "get student grades successfully",
+			input: "1",
+			expectedValue: []*models.GradeModel{
+				{
+					EnrollmentID: 1,
+					CourseID:     1,
+					CourseName:   "Math",
+					Credits:      4,
+					Term:         "Fall 2020",
+					StartTime:    "2020-11-02T00:00:00Z",
+					Letter:       &a,
+				},
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/grades.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.GetStudentGrades(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetTranscriptCourses(t *testing.T) {
+	a := "A"
+
+	testCases := []struct {
+		name          string
+		input         string
+		expectedValue []*TranscriptCourseEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "student without courses",
+			input:         "9",
+			expectedValue: []*TranscriptCourseEntity{},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/grades.sql",
+		},
+		{
+			name:  "get transcript courses successfully",
+			input: "1",
+			expectedValue: []*TranscriptCourseEntity{
+				{
+					EnrollmentID: 1,
+					CourseID:     1,
+					CourseName:   "Math",
+					Credits:      4,
+					Term:         "Fall 2020",
+					StartTime:    "2020-11-02T00:00:00Z",
+					EndTime:      "2020-11-03T00:00:00Z",
+					Status:       models.EnrollmentStatusCompleted,
+					GradeLetter:  &a,
+				},
+				{
+					EnrollmentID: 2,
+					CourseID:     2,
+					CourseName:   "Physics",
+					Credits:      3,
+					Term:         "Spring 2021",
+					StartTime:    "2021-02-01T00:00:00Z",
+					EndTime:      "2021-02-02T00:00:00Z",
+					Status:       models.EnrollmentStatusActive,
+				},
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/grades.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			result, err := studentRepo.GetTranscriptCourses(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_SetCreditOverride(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		inputOverride *models.CreditOverrideModel
+		expectedValue *models.CreditOverrideModel
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "student not found",
+			input:         "9",
+			inputOverride: &models.CreditOverrideModel{MaxCredits: 21, ApprovedBy: "advisor"},
+			expectedValue: nil,
+			expectedError: models.ErrStudentNotFound,
+			giveFixture:   "./testdata/student/grades.sql",
+		},
+		{
+			name:          "set credit override successfully",
+			input:         "1",
+			inputOverride: &models.CreditOverrideModel{MaxCredits: 21, ApprovedBy: "advisor"},
+			expectedValue: &models.CreditOverrideModel{MaxCredits: 21, ApprovedBy: "advisor"},
+			expectedError: nil,
+			giveFixture:   "./testdata/student/grades.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			studentRepo := Student{
+				Db: dbMock,
+			}
+
+			err := studentRepo.SetCreditOverride(testCase.input, testCase.inputOverride)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+				result, err := studentRepo.GetCreditOverride(testCase.input)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
+	"student_rest/models"
 )
 
 
@@ -12,14 +14,17 @@ type Teacher struct{
 type TeacherRepositories interface {
 	CreateTeacher(teacher *TeacherEntity) (*TeacherEntity, error)
 	GetTeacherByID(id string) (*TeacherEntity, error)
+	GetTeachers(filter *models.TeacherFilterModel) ([]*TeacherEntity, error)
 	DeleteTeacher(id string) error
 	UpdateTeacher(id string, teacher *TeacherEntity) error
+	ReassignAndDeleteTeacher(id string, reassignTo string) error
+	ArchiveTeacher(id string) error
 }
 
 func (_self Teacher) CreateTeacher(teacher *TeacherEntity) (*TeacherEntity, error) {
-	sqlStmt := `INSERT INTO teachers("first_name", "last_name", "date_of_birth") VALUES ($1, $2, $3) RETURNING id`
+	sqlStmt := `INSERT INTO teachers("first_name", "last_name", "date_of_birth", "department_id") VALUES ($1, $2, $3, $4) RETURNING id`
 	id := 0
-	err := _self.Db.QueryRow(sqlStmt, teacher.FirstName, teacher.LastName, teacher.DateOfBirth).Scan(&id)
+	err := _self.Db.QueryRow(sqlStmt, teacher.FirstName, teacher.LastName, teacher.DateOfBirth, teacher.DepartmentID).Scan(&id)
 	if err != nil {
 		return nil, err
 	}
@@ -28,23 +33,181 @@ func (_self Teacher) CreateTeacher(teacher *TeacherEntity) (*TeacherEntity, erro
 }
 
 func (_self Teacher) GetTeacherByID(id string) (*TeacherEntity, error) {
-	sqlStmt := `SELECT * FROM teachers WHERE id=$1`
+	sqlStmt := `SELECT id, first_name, last_name, date_of_birth, archived_at, department_id FROM teachers WHERE id=$1`
 	var teacher TeacherEntity
-	err := _self.Db.QueryRow(sqlStmt, id).Scan(&teacher.ID, &teacher.FirstName, &teacher.LastName, &teacher.DateOfBirth)
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&teacher.ID, &teacher.FirstName, &teacher.LastName, &teacher.DateOfBirth,
+		&teacher.ArchivedAt, &teacher.DepartmentID)
 	if err != nil {
 		return nil, err
 	}
 	return &teacher, nil
 }
 
+// GetTeachers lists the teachers ordered by name, only the ones of a department when the filter has one
+func (_self Teacher) GetTeachers(filter *models.TeacherFilterModel) ([]*TeacherEntity, error) {
+	sqlStmt := `SELECT id, first_name, last_name, date_of_birth, archived_at, department_id FROM teachers`
+	args := []interface{}{}
+	if filter.DepartmentID != "" {
+		departmentID, err := findDepartment(_self.Db, filter.DepartmentID)
+		if err != nil {
+			return nil, err
+		}
+		sqlStmt += ` WHERE department_id = $1`
+		args = append(args, departmentID)
+	}
+	sqlStmt += ` ORDER BY last_name, first_name, id`
+
+	rows, err := _self.Db.Query(sqlStmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teachers := []*TeacherEntity{}
+	for rows.Next() {
+		var teacher TeacherEntity
+		err = rows.Scan(&teacher.ID, &teacher.FirstName, &teacher.LastName, &teacher.DateOfBirth, &teacher.ArchivedAt,
+			&teacher.DepartmentID)
+		if err != nil {
+			return nil, err
+		}
+		teachers = append(teachers, &teacher)
+	}
+	return teachers, rows.Err()
+}
+
+// DeleteTeacher deletes the teacher unless they teach or co-teach a course, the teacher row stays locked between the
+// check and the delete so a course can't be assigned to the teacher in between
 func (_self Teacher) DeleteTeacher(id string) error {
-	sqlStmt := `DELETE FROM teachers WHERE id=$1`
-	_, err := _self.Db.Exec(sqlStmt, id)
-	return err
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	sqlStmt := `SELECT id FROM teachers WHERE id=$1 FOR UPDATE`
+	teacherID := 0
+	err = tx.QueryRowContext(ctx, sqlStmt, id).Scan(&teacherID)
+	if err == sql.ErrNoRows {
+		return models.ErrTeacherNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	courses, err := teacherCourses(ctx, tx, teacherID)
+	if err != nil {
+		return err
+	}
+	if len(courses) > 0 {
+		return &models.TeacherHasCoursesError{Courses: courses}
+	}
+
+	sqlStmt = `DELETE FROM teachers WHERE id=$1`
+	result, err := tx.ExecContext(ctx, sqlStmt, teacherID)
+	if err != nil {
+		return err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrTeacherNotFound
+	}
+
+	return tx.Commit()
+}
+
+// teacherCourses lists the courses the teacher teaches or co-teaches
+func teacherCourses(ctx context.Context, tx *sql.Tx, teacherID int) ([]*models.CourseModel, error) {
+	sqlStmt := `SELECT c.id, c.name, c.start_time, c.end_time FROM courses c
+		WHERE c.teacher_id = $1 OR EXISTS (SELECT 1 FROM course_teachers ct WHERE ct.course_id = c.id AND ct.teacher_id = $1)
+		ORDER BY c.start_time, c.id`
+	rows, err := tx.QueryContext(ctx, sqlStmt, teacherID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	courses := []*models.CourseModel{}
+	for rows.Next() {
+		var course models.CourseModel
+		if err = rows.Scan(&course.ID, &course.Name, &course.StartTime, &course.EndTime); err != nil {
+			return nil, err
+		}
+		courses = append(courses, &course)
+	}
+	return courses, rows.Err()
 }
 
 func (_self Teacher) UpdateTeacher(id string, teacher *TeacherEntity) error {
-	sqlStmt := `UPDATE teachers SET "first_name" = $2, "last_name" = $3, "date_of_birth" = $4 WHERE id=$1`
-	_, err := _self.Db.Exec(sqlStmt, id, teacher.FirstName, teacher.LastName, teacher.DateOfBirth)
+	sqlStmt := `UPDATE teachers SET "first_name" = $2, "last_name" = $3, "date_of_birth" = $4, "department_id" = $5 WHERE id=$1`
+	_, err := _self.Db.Exec(sqlStmt, id, teacher.FirstName, teacher.LastName, teacher.DateOfBirth, teacher.DepartmentID)
 	return err
 }
+
+// ReassignAndDeleteTeacher moves every course of the teacher to another teacher and deletes the teacher in one transaction
+func (_self Teacher) ReassignAndDeleteTeacher(id string, reassignTo string) error {
+	ctx := context.Background()
+	tx, err := _self.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	// The other teacher takes over the roles of the teacher in other courses, unless they already teach the course
+	sqlStmt := `DELETE FROM course_teachers ct
+		WHERE (ct.teacher_id = $2 AND ct.course_id IN (SELECT id FROM courses WHERE teacher_id = $1))
+			OR (ct.teacher_id = $1 AND (
+				EXISTS (SELECT 1 FROM course_teachers other WHERE other.course_id = ct.course_id AND other.teacher_id = $2)
+				OR EXISTS (SELECT 1 FROM courses c WHERE c.id = ct.course_id AND c.teacher_id = $2)))`
+	if _, err = tx.ExecContext(ctx, sqlStmt, id, reassignTo); err != nil {
+		return err
+	}
+
+	sqlStmt = `UPDATE course_teachers SET "teacher_id" = $2 WHERE teacher_id=$1`
+	if _, err = tx.ExecContext(ctx, sqlStmt, id, reassignTo); err != nil {
+		return err
+	}
+
+	sqlStmt = `UPDATE courses SET "teacher_id" = $2 WHERE teacher_id=$1`
+	if _, err = tx.ExecContext(ctx, sqlStmt, id, reassignTo); err != nil {
+		return err
+	}
+
+	sqlStmt = `DELETE FROM teachers WHERE id=$1`
+	result, err := tx.ExecContext(ctx, sqlStmt, id)
+	if err != nil {
+		return err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrTeacherNotFound
+	}
+
+	return tx.Commit()
+}
+
+// ArchiveTeacher marks the teacher archived, archiving again keeps the first archive time
+func (_self Teacher) ArchiveTeacher(id string) error {
+	sqlStmt := `UPDATE teachers SET "archived_at" = COALESCE(archived_at, now()) WHERE id=$1`
+	result, err := _self.Db.Exec(sqlStmt, id)
+	if err != nil {
+		return err
+	}
+	archived, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if archived == 0 {
+		return models.ErrTeacherNotFound
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"errors"
 	"github.com/stretchr/testify/require"
+	"student_rest/models"
 	"student_rest/testhelpers"
 	"student_rest/utils"
 	"testing"
@@ -137,11 +138,27 @@ func Test_DeleteTeacher(t *testing.T) {
 		giveFixture   string
 	}{
 		{
-			name:          "delete teacher fail",
-			input:         "1",
-			expectedError: errors.New("pq: update or delete on table \"teachers\" violates foreign key constraint \"courses_teacher_id_fkey\" on table \"courses\""),
+			name:          "teacher not found",
+			input:         "9",
+			expectedError: models.ErrTeacherNotFound,
 			giveFixture:   "./testdata/teacher/teacher.sql",
 		},
+		{
+			name:  "teacher still has courses",
+			input: "1",
+			expectedError: &models.TeacherHasCoursesError{Courses: []*models.CourseModel{
+				{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"},
+			}},
+			giveFixture: "./testdata/teacher/teacher.sql",
+		},
+		{
+			name:  "teacher still co-teaches a course",
+			input: "3",
+			expectedError: &models.TeacherHasCoursesError{Courses: []*models.CourseModel{
+				{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"},
+			}},
+			giveFixture: "./testdata/course/course_teachers.sql",
+		},
 		{
 			name:          "delete teacher successfully",
 			input:         "2",
@@ -164,7 +181,7 @@ func Test_DeleteTeacher(t *testing.T) {
 
 			if testCase.expectedError != nil {
 				// For Fail Logic
-				require.EqualError(t, err, testCase.expectedError.Error())
+				require.Equal(t, testCase.expectedError, err)
 			} else {
 				// For Success Logic
 				require.NoError(t, err)
@@ -227,3 +244,106 @@ func Test_UpdateTeacher(t *testing.T) {
 		})
 	}
 }
+
+func Test_ReassignAndDeleteTeacher(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		inputTo       string
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "teacher not found",
+			input:         "9",
+			inputTo:       "2",
+			expectedError: models.ErrTeacherNotFound,
+			giveFixture:   "./testdata/teacher/teacher.sql",
+		},
+		{
+			name:          "reassign courses and delete teacher successfully",
+			input:         "1",
+			inputTo:       "2",
+			expectedError: nil,
+			giveFixture:   "./testdata/teacher/teacher.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			teacherRepo := Teacher{
+				Db: dbMock,
+			}
+
+			err := teacherRepo.ReassignAndDeleteTeacher(testCase.input, testCase.inputTo)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+
+				teacherID := 0
+				err = dbMock.QueryRow(`SELECT teacher_id FROM courses WHERE id=1`).Scan(&teacherID)
+				require.NoError(t, err)
+				require.Equal(t, 2, teacherID)
+
+				_, err = teacherRepo.GetTeacherByID(testCase.input)
+				require.EqualError(t, err, "sql: no rows in result set")
+			}
+		})
+	}
+}
+
+func Test_ArchiveTeacher(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "teacher not found",
+			input:         "9",
+			expectedError: models.ErrTeacherNotFound,
+			giveFixture:   "./testdata/teacher/teacher.sql",
+		},
+		{
+			name:          "archive teacher successfully",
+			input:         "1",
+			expectedError: nil,
+			giveFixture:   "./testdata/teacher/teacher.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			teacherRepo := Teacher{
+				Db: dbMock,
+			}
+
+			err := teacherRepo.ArchiveTeacher(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+
+				teacher, err := teacherRepo.GetTeacherByID(testCase.input)
+				require.NoError(t, err)
+				require.NotNil(t, teacher.ArchivedAt)
+			}
+		})
+	}
+}
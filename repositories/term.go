@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"database/sql"
+	"student_rest/models"
+)
+
+type Term struct {
+	Db *sql.DB
+}
+
+type TermRepositories interface {
+	CreateTerm(term *TermEntity) (*TermEntity, error)
+	GetTerms() ([]*TermEntity, error)
+	GetTermByID(id string) (*TermEntity, error)
+	UpdateTerm(id string, term *TermEntity) error
+	DeleteTerm(id string) error
+}
+
+func (_self Term) CreateTerm(term *TermEntity) (*TermEntity, error) {
+	sqlStmt := `INSERT INTO terms("name", "start_date", "end_date", "status") VALUES ($1, $2, $3, $4)
+		RETURNING id, start_date, end_date`
+	err := _self.Db.QueryRow(sqlStmt, term.Name, term.StartDate, term.EndDate, term.Status).
+		Scan(&term.ID, &term.StartDate, &term.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	return term, nil
+}
+
+func (_self Term) GetTerms() ([]*TermEntity, error) {
+	sqlStmt := `SELECT id, name, start_date, end_date, status FROM terms ORDER BY start_date, id`
+	rows, err := _self.Db.Query(sqlStmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	terms := []*TermEntity{}
+	for rows.Next() {
+		var term TermEntity
+		if err = rows.Scan(&term.ID, &term.Name, &term.StartDate, &term.EndDate, &term.Status); err != nil {
+			return nil, err
+		}
+		terms = append(terms, &term)
+	}
+	return terms, rows.Err()
+}
+
+func (_self Term) GetTermByID(id string) (*TermEntity, error) {
+	sqlStmt := `SELECT id, name, start_date, end_date, status FROM terms WHERE id=$1`
+	var term TermEntity
+	err := _self.Db.QueryRow(sqlStmt, id).Scan(&term.ID, &term.Name, &term.StartDate, &term.EndDate, &term.Status)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrTermNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &term, nil
+}
+
+// UpdateTerm updates the term, the new dates must still cover every course of the term
+func (_self Term) UpdateTerm(id string, term *TermEntity) error {
+	sqlStmt := `UPDATE terms SET "name" = $2, "start_date" = $3, "end_date" = $4, "status" = $5
+		WHERE id=$1 AND NOT EXISTS(
+			SELECT 1 FROM courses WHERE term_id=$1 AND (start_time < $3::date OR end_time > $4::date + interval '1 day'))
+		RETURNING id`
+	termID := 0
+	err := _self.Db.QueryRow(sqlStmt, id, term.Name, term.StartDate, term.EndDate, term.Status).Scan(&termID)
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	// Nothing was updated, either the term doesn't exist or a course falls outside the new dates
+	if _, err = _self.GetTermByID(id); err != nil {
+		return err
+	}
+	return models.ErrTermExcludesCourses
+}
+
+// DeleteTerm deletes the term, a term that still has courses can't be deleted
+func (_self Term) DeleteTerm(id string) error {
+	sqlStmt := `DELETE FROM terms WHERE id=$1 AND NOT EXISTS(SELECT 1 FROM courses WHERE term_id=$1)`
+	result, err := _self.Db.Exec(sqlStmt, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		return nil
+	}
+
+	if _, err = _self.GetTermByID(id); err != nil {
+		return err
+	}
+	return models.ErrTermHasCourses
+}
+
+// getTerm returns the term a course belongs to
+func getTerm(db *sql.DB, id int) (*models.TermModel, error) {
+	sqlStmt := `SELECT id, name, start_date, end_date, status FROM terms WHERE id=$1`
+	var term models.TermModel
+	err := db.QueryRow(sqlStmt, id).Scan(&term.ID, &term.Name, &term.StartDate, &term.EndDate, &term.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &term, nil
+}
@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/testhelpers"
+	"student_rest/utils"
+	"testing"
+)
+
+func Test_UpdateTerm(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputID       string
+		input         *TermEntity
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:    "term not found",
+			inputID: "9",
+			input: &TermEntity{
+				Name:      "Fall 2020",
+				StartDate: "2020-08-15",
+				EndDate:   "2020-12-31",
+				Status:    models.TermStatusActive,
+			},
+			expectedError: models.ErrTermNotFound,
+			giveFixture:   "./testdata/term/term.sql",
+		},
+		{
+			name:    "new dates exclude a course",
+			inputID: "1",
+			input: &TermEntity{
+				Name:      "Fall 2020",
+				StartDate: "2020-08-15",
+				EndDate:   "2020-10-31",
+				Status:    models.TermStatusActive,
+			},
+			expectedError: models.ErrTermExcludesCourses,
+			giveFixture:   "./testdata/term/term.sql",
+		},
+		{
+			name:    "update term successfully",
+			inputID: "1",
+			input: &TermEntity{
+				Name:      "Fall 2020",
+				StartDate: "2020-09-01",
+				EndDate:   "2020-12-20",
+				Status:    models.TermStatusClosed,
+			},
+			expectedError: nil,
+			giveFixture:   "./testdata/term/term.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			termRepo := Term{
+				Db: dbMock,
+			}
+
+			err := termRepo.UpdateTerm(testCase.inputID, testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+
+				result, err := termRepo.GetTermByID(testCase.inputID)
+				require.NoError(t, err)
+				require.Equal(t, "2020-09-01T00:00:00Z", result.StartDate)
+				require.Equal(t, "2020-12-20T00:00:00Z", result.EndDate)
+				require.Equal(t, testCase.input.Status, result.Status)
+			}
+		})
+	}
+}
+
+func Test_DeleteTerm(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+		giveFixture   string
+	}{
+		{
+			name:          "term not found",
+			input:         "9",
+			expectedError: models.ErrTermNotFound,
+			giveFixture:   "./testdata/term/term.sql",
+		},
+		{
+			name:          "term has courses",
+			input:         "1",
+			expectedError: models.ErrTermHasCourses,
+			giveFixture:   "./testdata/term/term.sql",
+		},
+		{
+			name:          "delete term successfully",
+			input:         "2",
+			expectedError: nil,
+			giveFixture:   "./testdata/term/term.sql",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbMock, _ := testhelpers.ConnectDB()
+
+			utils.LoadFixture(dbMock, testCase.giveFixture)
+
+			termRepo := Term{
+				Db: dbMock,
+			}
+
+			err := termRepo.DeleteTerm(testCase.input)
+
+			if testCase.expectedError != nil {
+				// For Fail Logic
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				// For Success Logic
+				require.NoError(t, err)
+
+				_, err = termRepo.GetTermByID(testCase.input)
+				require.EqualError(t, err, models.ErrTermNotFound.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+TRUNCATE TABLE program_requirement_courses, program_requirements, room_bookings, rooms, attendance, course_sessions, course_meeting_patterns, course_teachers, course_prerequisites, course_registration_overrides, student_credit_overrides, enrollment_status_history, students_courses, students, teachers, courses, terms, programs, departments;
+
+INSERT INTO teachers(
+	id, first_name, last_name, date_of_birth)
+	VALUES (1, 'Anh', 'Le', '11/2/1998');
+
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (1, 'Fall 2020', '2020-08-15', '2020-12-31', 'active');
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (1, 'Math', '11/2/2020', '11/3/2020', 1, 1);
+
+INSERT INTO students(
+	id, student_id, first_name, last_name, date_of_birth)
+	VALUES (1, '123456', 'Anh', 'Le', '11/2/1998');
+
+INSERT INTO students(
+	id, student_id, first_name, last_name, date_of_birth)
+	VALUES (2, '234567', 'Mai', 'Dao', '11/2/1998');
+
+INSERT INTO students(
+	id, student_id, first_name, last_name, date_of_birth)
+	VALUES (3, '345678', 'Duyen', 'Nguyen', '11/2/1998');
+
+INSERT INTO students_courses(
+	id, student_id, course_id, status)
+	VALUES (1, 1, 1, 'active');
+
+INSERT INTO students_courses(
+	id, student_id, course_id, status)
+	VALUES (2, 2, 1, 'active');
+
+INSERT INTO students_courses(
+	id, student_id, course_id, status)
+	VALUES (3, 3, 1, 'withdrawn');
@@ -0,0 +1,36 @@
+TRUNCATE TABLE program_requirement_courses, program_requirements, room_bookings, rooms, attendance, course_sessions, course_meeting_patterns, course_teachers, course_prerequisites, course_registration_overrides, student_credit_overrides, enrollment_status_history, students_courses, students, teachers, courses, terms, programs, departments;
+
+INSERT INTO teachers(
+	id, first_name, last_name, date_of_birth)
+	VALUES (1, 'Anh', 'Le', '11/2/1998');
+
+INSERT INTO teachers(
+	id, first_name, last_name, date_of_birth)
+	VALUES (2, 'Binh', 'Tran', '5/4/1995');
+
+INSERT INTO teachers(
+	id, first_name, last_name, date_of_birth)
+	VALUES (3, 'Chi', 'Nguyen', '7/9/1996');
+
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (1, 'Fall 2020', '2020-08-15', '2020-12-31', 'active');
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (1, 'Math', '11/2/2020', '11/3/2020', 1, 1);
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (2, 'Physics', '11/4/2020', '11/5/2020', 1, 2);
+
+INSERT INTO course_teachers(
+	course_id, teacher_id, role)
+	VALUES (1, 3, 'grader');
+
+INSERT INTO course_teachers(
+	course_id, teacher_id, role)
+	VALUES (1, 2, 'assistant');
+
+SELECT setval('teachers_id_seq', (SELECT MAX(id) FROM teachers));
+SELECT setval('courses_id_seq', (SELECT MAX(id) FROM courses));
@@ -0,0 +1,43 @@
+TRUNCATE TABLE program_requirement_courses, program_requirements, room_bookings, rooms, attendance, course_sessions, course_meeting_patterns, course_teachers, course_prerequisites, course_registration_overrides, student_credit_overrides, enrollment_status_history, students_courses, students, teachers, courses, terms, programs, departments;
+
+INSERT INTO departments(
+	id, code, name)
+	VALUES (1, 'PHYS', 'Physics');
+
+INSERT INTO programs(
+	id, department_id, code, name, degree)
+	VALUES (1, 1, 'BSC-PHYS', 'Physics', 'BSc');
+
+INSERT INTO programs(
+	id, department_id, code, name, degree)
+	VALUES (2, 1, 'MSC-PHYS', 'Physics', 'MSc');
+
+INSERT INTO students(
+	id, student_id, first_name, last_name, date_of_birth, program_id)
+	VALUES (1, '123456', 'Anh', 'Le', '11/2/1998', 1);
+
+INSERT INTO teachers(
+	id, first_name, last_name, date_of_birth)
+	VALUES (1, 'Mai', 'Dao', '11/2/1998');
+
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (1, 'Fall 2020', '2020-08-15', '2020-12-31', 'active');
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id, credits)
+	VALUES (1, 'Mechanics', '11/2/2020', '11/3/2020', 1, 1, 4);
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id, credits)
+	VALUES (2, 'Optics', '11/4/2020', '11/5/2020', 1, 1, 3);
+
+INSERT INTO program_requirements(
+	id, program_id, name, kind, credits, min_grade)
+	VALUES (1, 2, 'Core', 'courses', NULL, 'C');
+
+INSERT INTO program_requirement_courses(
+	requirement_id, course_id)
+	VALUES (1, 1);
+
+SELECT setval('program_requirements_id_seq', (SELECT MAX(id) FROM program_requirements));
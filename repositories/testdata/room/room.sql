@@ -0,0 +1,57 @@
+TRUNCATE TABLE program_requirement_courses, program_requirements, room_bookings, rooms, attendance, course_sessions, course_meeting_patterns, course_teachers, course_prerequisites, course_registration_overrides, student_credit_overrides, enrollment_status_history, students_courses, students, teachers, courses, terms, programs, departments;
+
+INSERT INTO teachers(
+	id, first_name, last_name, date_of_birth)
+	VALUES (1, 'Anh', 'Le', '11/2/1998');
+
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (1, 'Fall 2020', '2020-08-15', '2020-12-31', 'active');
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (1, 'Math', '2020-11-02', '2020-11-10', 1, 1);
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (2, 'Physics', '2020-11-04', '2020-11-05', 1, 1);
+
+INSERT INTO course_sessions(
+	course_id, session_date, start_time, end_time)
+	VALUES (1, '2020-11-02', '2020-11-02 09:00', '2020-11-02 10:30');
+
+INSERT INTO course_sessions(
+	course_id, session_date, start_time, end_time)
+	VALUES (1, '2020-11-09', '2020-11-09 09:00', '2020-11-09 10:30');
+
+INSERT INTO rooms(
+	id, building, number, capacity, features)
+	VALUES (1, 'A', '101', 30, '{projector}');
+
+INSERT INTO rooms(
+	id, building, number, capacity, features)
+	VALUES (2, 'B', '201', 80, '{lab,projector}');
+
+INSERT INTO rooms(
+	id, building, number, capacity, features)
+	VALUES (3, 'C', '301', 10, '{}');
+
+INSERT INTO rooms(
+	id, building, number, capacity, features)
+	VALUES (4, 'D', '401', 5, '{}');
+
+-- Math is in room 1 except for its session on 2020-11-09 in room 2, Physics has no sessions and takes room 3
+INSERT INTO room_bookings(
+	room_id, course_id, session_date)
+	VALUES (1, 1, NULL);
+
+INSERT INTO room_bookings(
+	room_id, course_id, session_date)
+	VALUES (2, 1, '2020-11-09');
+
+INSERT INTO room_bookings(
+	room_id, course_id, session_date)
+	VALUES (3, 2, NULL);
+
+SELECT setval('courses_id_seq', (SELECT MAX(id) FROM courses));
+SELECT setval('rooms_id_seq', (SELECT MAX(id) FROM rooms));
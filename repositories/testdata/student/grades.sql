@@ -0,0 +1,43 @@
+TRUNCATE TABLE program_requirement_courses, program_requirements, room_bookings, rooms, attendance, course_sessions, course_meeting_patterns, course_teachers, course_prerequisites, course_registration_overrides, student_credit_overrides, enrollment_status_history, students_courses, students, teachers, courses, terms, programs, departments;
+
+INSERT INTO students(
+	id, student_id, first_name, last_name, date_of_birth)
+	VALUES (1, '123456', 'Anh', 'Le', '11/2/1998');
+
+INSERT INTO students(
+	id, student_id, first_name, last_name, date_of_birth)
+	VALUES (2, '234567', 'Mai', 'Dao', '11/2/1998');
+
+INSERT INTO teachers(
+	id, first_name, last_name, date_of_birth)
+	VALUES (1, 'Anh', 'Le', '11/2/1998');
+
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (1, 'Fall 2020', '2020-08-15', '2020-12-31', 'active');
+
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (2, 'Spring 2021', '2021-01-15', '2021-05-31', 'planned');
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id, credits)
+	VALUES (1, 'Math', '11/2/2020', '11/3/2020', 1, 1, 4);
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id, credits)
+	VALUES (2, 'Physics', '2/1/2021', '2/2/2021', 2, 1, 3);
+
+INSERT INTO students_courses(
+	id, student_id, course_id, status, grade_letter, graded_at)
+	VALUES (1, 1, 1, 'completed', 'A', '11/4/2020');
+
+INSERT INTO students_courses(
+	id, student_id, course_id, status)
+	VALUES (2, 1, 2, 'active');
+
+INSERT INTO students_courses(
+	id, student_id, course_id, status)
+	VALUES (3, 2, 1, 'withdrawn');
+
+SELECT setval('students_courses_id_seq', (SELECT MAX(id) FROM students_courses));
@@ -1,4 +1,4 @@
-TRUNCATE TABLE students_courses, students, teachers, courses;
+TRUNCATE TABLE program_requirement_courses, program_requirements, room_bookings, rooms, attendance, course_sessions, course_meeting_patterns, course_teachers, course_prerequisites, course_registration_overrides, student_credit_overrides, enrollment_status_history, students_courses, students, teachers, courses, terms, programs, departments;
 
 INSERT INTO public.students(
 	id, student_id, first_name, last_name, date_of_birth)
@@ -18,13 +18,17 @@ INSERT INTO teachers(
 	VALUES (2, 'Duyen', 'Nguyen', '11/2/1998');
 
 
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (1, 'Fall 2020', '2020-08-15', '2020-12-31', 'active');
+
 INSERT INTO courses(
-	id, name, start_time, end_time, teacher_id)
-	VALUES (1, 'Math', '11/2/2020', '11/3/2020', 1);
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (1, 'Math', '11/2/2020', '11/3/2020', 1, 1);
 
 INSERT INTO courses(
-	id, name, start_time, end_time, teacher_id)
-	VALUES (2, 'Physics', '11/2/2020', '11/3/2020', 2);
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (2, 'Physics', '11/2/2020', '11/3/2020', 1, 2);
 
 
 INSERT INTO students_courses(
@@ -34,3 +38,4 @@ INSERT INTO students_courses(
 
 
 
+SELECT setval('students_courses_id_seq', (SELECT MAX(id) FROM students_courses));
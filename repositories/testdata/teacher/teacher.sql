@@ -1,4 +1,4 @@
-TRUNCATE TABLE students_courses, students, teachers, courses;
+TRUNCATE TABLE program_requirement_courses, program_requirements, room_bookings, rooms, attendance, course_sessions, course_meeting_patterns, course_teachers, course_prerequisites, course_registration_overrides, student_credit_overrides, enrollment_status_history, students_courses, students, teachers, courses, terms, programs, departments;
 
 
 INSERT INTO teachers(
@@ -10,7 +10,11 @@ INSERT INTO teachers(
 	VALUES (2, 'Duyen', 'Nguyen', '11/2/1998');
 
 
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (1, 'Fall 2020', '2020-08-15', '2020-12-31', 'active');
+
 INSERT INTO courses(
-	id, name, start_time, end_time, teacher_id)
-	VALUES (1, 'Math', '11/2/2020', '11/3/2020', 1);
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (1, 'Math', '11/2/2020', '11/3/2020', 1, 1);
 
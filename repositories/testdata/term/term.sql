@@ -0,0 +1,21 @@
+TRUNCATE TABLE program_requirement_courses, program_requirements, room_bookings, rooms, attendance, course_sessions, course_meeting_patterns, course_teachers, course_prerequisites, course_registration_overrides, student_credit_overrides, enrollment_status_history, students_courses, students, teachers, courses, terms, programs, departments;
+
+INSERT INTO teachers(
+	id, first_name, last_name, date_of_birth)
+	VALUES (1, 'Anh', 'Le', '11/2/1998');
+
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (1, 'Fall 2020', '2020-08-15', '2020-12-31', 'active');
+
+INSERT INTO terms(
+	id, name, start_date, end_date, status)
+	VALUES (2, 'Spring 2021', '2021-01-15', '2021-05-31', 'planned');
+
+INSERT INTO courses(
+	id, name, start_time, end_time, term_id, teacher_id)
+	VALUES (1, 'Math', '11/2/2020', '11/3/2020', 1, 1);
+
+SELECT setval('terms_id_seq', (SELECT MAX(id) FROM terms));
+SELECT setval('teachers_id_seq', (SELECT MAX(id) FROM teachers));
+SELECT setval('courses_id_seq', (SELECT MAX(id) FROM courses));
@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"student_rest/models"
+)
+
+func countActiveEnrollments(ctx context.Context, tx *sql.Tx, courseID int) (int, error) {
+	sqlStmt := `SELECT COUNT(*) FROM students_courses WHERE course_id=$1 AND status=$2`
+	count := 0
+	err := tx.QueryRowContext(ctx, sqlStmt, courseID, models.EnrollmentStatusActive).Scan(&count)
+	return count, err
+}
+
+// waitlistPosition returns the 1-based position of the waitlisted enrollment, the waitlist is ordered by enrollment id
+func waitlistPosition(ctx context.Context, tx *sql.Tx, enrollmentID int, courseID int) (int, error) {
+	sqlStmt := `SELECT COUNT(*) FROM students_courses WHERE course_id=$1 AND status=$2 AND id <= $3`
+	position := 0
+	err := tx.QueryRowContext(ctx, sqlStmt, courseID, models.EnrollmentStatusWaitlisted, enrollmentID).Scan(&position)
+	return position, err
+}
+
+// promoteWaitlisted moves students from the head of the waitlist into the course while it has free seats.
+// A student the course would take over their credit limit for the term stays on the waitlist, the limit is
+// the approved overload of the student or else maxCreditLoad, a zero maxCreditLoad doesn't limit credits.
+// The caller must hold the lock on the course row.
+func promoteWaitlisted(ctx context.Context, tx *sql.Tx, courseID int, maxCreditLoad int) error {
+	sqlStmt := `SELECT capacity, credits, term_id FROM courses WHERE id=$1`
+	var capacity *int
+	credits, termID := 0, 0
+	if err := tx.QueryRowContext(ctx, sqlStmt, courseID).Scan(&capacity, &credits, &termID); err != nil {
+		return err
+	}
+
+	enrolled, err := countActiveEnrollments(ctx, tx, courseID)
+	if err != nil {
+		return err
+	}
+	if capacity != nil && enrolled >= *capacity {
+		return nil
+	}
+
+	sqlStmt = `SELECT id, student_id FROM students_courses WHERE course_id=$1 AND status=$2 ORDER BY id FOR UPDATE`
+	rows, err := tx.QueryContext(ctx, sqlStmt, courseID, models.EnrollmentStatusWaitlisted)
+	if err != nil {
+		return err
+	}
+	waitlist := []*EnrollmentEntity{}
+	for rows.Next() {
+		var enrollment EnrollmentEntity
+		if err = rows.Scan(&enrollment.ID, &enrollment.StudentID); err != nil {
+			rows.Close()
+			return err
+		}
+		waitlist = append(waitlist, &enrollment)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, enrollment := range waitlist {
+		if capacity != nil && enrolled >= *capacity {
+			return nil
+		}
+
+		if maxCreditLoad > 0 && credits > 0 {
+			if _, err = lockStudent(ctx, tx, enrollment.StudentID); err != nil {
+				return err
+			}
+			withinLimit, err := withinCreditLimit(ctx, tx, enrollment.StudentID, termID, credits, maxCreditLoad)
+			if err != nil {
+				return err
+			}
+			if !withinLimit {
+				continue
+			}
+		}
+
+		sqlStmt = `UPDATE students_courses SET "status" = $2 WHERE id=$1`
+		if _, err = tx.ExecContext(ctx, sqlStmt, enrollment.ID, models.EnrollmentStatusActive); err != nil {
+			return err
+		}
+		err = recordStatusChange(ctx, tx, enrollment.ID, models.EnrollmentStatusWaitlisted, models.EnrollmentStatusActive,
+			"promoted from the waitlist", "")
+		if err != nil {
+			return err
+		}
+		enrolled++
+	}
+	return nil
+}
+
+// withinCreditLimit reports whether the locked student can add the credits to their active courses of the term
+func withinCreditLimit(ctx context.Context, tx *sql.Tx, studentID int, termID int, credits int, maxCreditLoad int) (bool, error) {
+	current, limit, err := creditLoad(ctx, tx, studentID, termID, maxCreditLoad)
+	if err != nil {
+		return false, err
+	}
+	return current+credits <= limit, nil
+}
+
+// creditLoad returns the credits of the active courses of the locked student in the term and their credit limit,
+// the approved overload of the student or else maxCreditLoad
+func creditLoad(ctx context.Context, tx *sql.Tx, studentID int, termID int, maxCreditLoad int) (int, int, error) {
+	sqlStmt := `SELECT COALESCE(SUM(c.credits), 0), COALESCE((SELECT max_credits FROM student_credit_overrides WHERE student_id=$1), $3)
+		FROM students_courses sc
+		JOIN courses c ON c.id = sc.course_id
+		WHERE sc.student_id = $1 AND sc.status = $4 AND c.term_id = $2`
+	current, limit := 0, 0
+	err := tx.QueryRowContext(ctx, sqlStmt, studentID, termID, maxCreditLoad, models.EnrollmentStatusActive).Scan(&current, &limit)
+	return current, limit, err
+}
+
+// checkCreditLimit returns a CreditLimitExceededError when the locked course takes the locked student over their
+// credit limit for its term, a zero maxCreditLoad doesn't limit credits
+func checkCreditLimit(ctx context.Context, tx *sql.Tx, studentID int, course *CourseEntity, maxCreditLoad int) error {
+	if maxCreditLoad == 0 || course.Credits == 0 {
+		return nil
+	}
+
+	current, limit, err := creditLoad(ctx, tx, studentID, course.TermID, maxCreditLoad)
+	if err != nil {
+		return err
+	}
+	if current+course.Credits <= limit {
+		return nil
+	}
+
+	term := ""
+	if err = tx.QueryRowContext(ctx, `SELECT name FROM terms WHERE id=$1`, course.TermID).Scan(&term); err != nil {
+		return err
+	}
+	return &models.CreditLimitExceededError{
+		Term:           term,
+		CurrentCredits: current,
+		CourseCredits:  course.Credits,
+		Limit:          limit,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"github.com/go-chi/chi"
 	"student_rest/handlers"
+	"student_rest/models"
 	"student_rest/repositories"
 	"student_rest/services"
 )
@@ -11,13 +12,61 @@ import (
 func CreateRoutes(db *sql.DB) *chi.Mux {
 	r := chi.NewRouter()
 
+	attendanceHandlers := handlers.AttendanceHandlers{
+		AttendanceServices: services.Attendance{
+			AttendanceRepositories: repositories.Attendance{
+				Db: db,
+			},
+			CourseRepositories: repositories.Course{
+				Db: db,
+			},
+		},
+	}
+
+	courseHandlers := handlers.CourseHandlers{
+		CourseServices: services.Course{
+			CourseRepositories: repositories.Course{
+				Db: db,
+			},
+			StudentRepositories: repositories.Student{
+				Db: db,
+			},
+			TermRepositories: repositories.Term{
+				Db: db,
+			},
+			TeacherRepositories: repositories.Teacher{
+				Db: db,
+			},
+			RoomRepositories: repositories.Room{
+				Db: db,
+			},
+			DepartmentRepositories: repositories.Department{
+				Db: db,
+			},
+			GradeScale:      models.DefaultGradeScale,
+			MaxCreditLoad:   models.DefaultMaxCreditLoad,
+			TeacherWorkload: models.DefaultTeacherWorkload,
+		},
+	}
+
 	r.Route("/students", func(r chi.Router) {
 		studentHandlers := handlers.StudentHandlers{
 			StudentServices: services.Student{
 				StudentRepositories: repositories.Student{
 					Db: db,
 				},
-				Utils: services.Utils{},
+				CourseRepositories: repositories.Course{
+					Db: db,
+				},
+				TermRepositories: repositories.Term{
+					Db: db,
+				},
+				ProgramRepositories: repositories.Program{
+					Db: db,
+				},
+				Utils:         services.Utils{},
+				GradeScale:    models.DefaultGradeScale,
+				MaxCreditLoad: models.DefaultMaxCreditLoad,
 			},
 		}
 
@@ -26,6 +75,17 @@ func CreateRoutes(db *sql.DB) *chi.Mux {
 		r.MethodFunc("delete", "/student/{id}", studentHandlers.DeleteStudent)
 		r.MethodFunc("put", "/student/{id}", studentHandlers.UpdateStudent)
 		r.MethodFunc("post", "/register-course", studentHandlers.RegisterCourse)
+		r.MethodFunc("get", "/student/{id}/courses", studentHandlers.GetStudentCourses)
+		r.MethodFunc("post", "/student/{id}/courses/{courseId}", studentHandlers.EnrollCourse)
+		r.MethodFunc("post", "/student/{id}/courses/{courseId}/withdraw", studentHandlers.WithdrawCourse)
+		r.MethodFunc("put", "/student/{id}/courses/{courseId}/grade", studentHandlers.RecordGrade)
+		r.MethodFunc("get", "/student/{id}/gpa", studentHandlers.GetStudentGPA)
+		r.MethodFunc("get", "/student/{id}/transcript", studentHandlers.GetStudentTranscript)
+		r.MethodFunc("put", "/student/{id}/credit-override", studentHandlers.SetCreditOverride)
+		r.MethodFunc("delete", "/student/{id}/credit-override", studentHandlers.DeleteCreditOverride)
+		r.MethodFunc("get", "/student/{id}/attendance", attendanceHandlers.GetStudentAttendance)
+		r.MethodFunc("get", "/student/{id}/schedule.ics", studentHandlers.GetStudentSchedule)
+		r.MethodFunc("get", "/student/{id}/audit", studentHandlers.GetStudentAudit)
 	})
 
 	r.Route("/teachers", func(r chi.Router) {
@@ -34,27 +94,154 @@ func CreateRoutes(db *sql.DB) *chi.Mux {
 				TeacherRepositories: repositories.Teacher{
 					Db: db,
 				},
+				CourseRepositories: repositories.Course{
+					Db: db,
+				},
+				DepartmentRepositories: repositories.Department{
+					Db: db,
+				},
+				TeacherWorkload: models.DefaultTeacherWorkload,
 			},
 		}
 
 		r.MethodFunc("post", "/", teacherHandlers.CreateTeacher)
+		r.MethodFunc("get", "/", teacherHandlers.GetTeachers)
 		r.MethodFunc("get", "/teacher/{id}", teacherHandlers.GetTeacherByID)
 		r.MethodFunc("delete", "/teacher/{id}", teacherHandlers.DeleteTeacher)
 		r.MethodFunc("put", "/teacher/{id}", teacherHandlers.UpdateTeacher)
+		r.MethodFunc("get", "/teacher/{id}/courses", teacherHandlers.GetTeacherCourses)
+		r.MethodFunc("get", "/teacher/{id}/schedule", teacherHandlers.GetTeacherSchedule)
+		r.MethodFunc("get", "/teacher/{id}/schedule.ics", teacherHandlers.GetTeacherScheduleCalendar)
 	})
 	r.Route("/courses", func(r chi.Router) {
-		courseHandlers := handlers.CourseHandlers{
-			CourseServices: services.Course{
+		r.MethodFunc("post", "/", courseHandlers.CreateCourse)
+		r.MethodFunc("get", "/", courseHandlers.GetCourses)
+		r.MethodFunc("get", "/course/{id}", courseHandlers.GetCourseByID)
+		r.MethodFunc("delete", "/course/{id}", courseHandlers.DeleteCourse)
+		r.MethodFunc("put", "/course/{id}", courseHandlers.UpdateCourse)
+		r.MethodFunc("get", "/course/{id}/students", courseHandlers.GetCourseStudents)
+		r.MethodFunc("get", "/course/{id}/prerequisites", courseHandlers.GetPrerequisites)
+		r.MethodFunc("post", "/course/{id}/prerequisites/{prerequisiteId}", courseHandlers.AddPrerequisite)
+		r.MethodFunc("delete", "/course/{id}/prerequisites/{prerequisiteId}", courseHandlers.DeletePrerequisite)
+		r.MethodFunc("post", "/course/{id}/enrollments:bulk", courseHandlers.BulkEnrollCourse)
+		r.MethodFunc("put", "/course/{id}/registration-overrides/{studentId}", courseHandlers.SetRegistrationOverride)
+		r.MethodFunc("delete", "/course/{id}/registration-overrides/{studentId}", courseHandlers.DeleteRegistrationOverride)
+		r.MethodFunc("put", "/course/{id}/students/{studentId}/grade", courseHandlers.RecordGrade)
+		r.MethodFunc("put", "/course/{id}/meeting-pattern", courseHandlers.SetMeetingPattern)
+		r.MethodFunc("get", "/course/{id}/meeting-pattern", courseHandlers.GetMeetingPattern)
+		r.MethodFunc("delete", "/course/{id}/meeting-pattern", courseHandlers.DeleteMeetingPattern)
+		r.MethodFunc("get", "/course/{id}/sessions", courseHandlers.GetCourseSessions)
+		r.MethodFunc("put", "/course/{id}/sessions/{date}/attendance", attendanceHandlers.MarkRoster)
+		r.MethodFunc("get", "/course/{id}/attendance", attendanceHandlers.GetCourseAttendance)
+		r.MethodFunc("get", "/course/{id}/teachers", courseHandlers.GetCourseTeachers)
+		r.MethodFunc("put", "/course/{id}/teachers/{teacherId}", courseHandlers.SetCourseTeacher)
+		r.MethodFunc("delete", "/course/{id}/teachers/{teacherId}", courseHandlers.DeleteCourseTeacher)
+		r.MethodFunc("get", "/course/{id}/rooms", courseHandlers.GetCourseRooms)
+		r.MethodFunc("put", "/course/{id}/room", courseHandlers.BookCourseRoom)
+		r.MethodFunc("delete", "/course/{id}/room", courseHandlers.DeleteCourseRoom)
+		r.MethodFunc("put", "/course/{id}/sessions/{date}/room", courseHandlers.BookSessionRoom)
+		r.MethodFunc("delete", "/course/{id}/sessions/{date}/room", courseHandlers.DeleteSessionRoom)
+	})
+
+	r.Route("/terms", func(r chi.Router) {
+		termHandlers := handlers.TermHandlers{
+			TermServices: services.Term{
+				TermRepositories: repositories.Term{
+					Db: db,
+				},
+			},
+		}
+
+		r.MethodFunc("post", "/", termHandlers.CreateTerm)
+		r.MethodFunc("get", "/", termHandlers.GetTerms)
+		r.MethodFunc("get", "/term/{id}", termHandlers.GetTermByID)
+		r.MethodFunc("put", "/term/{id}", termHandlers.UpdateTerm)
+		r.MethodFunc("delete", "/term/{id}", termHandlers.DeleteTerm)
+	})
+
+	r.Route("/rooms", func(r chi.Router) {
+		roomHandlers := handlers.RoomHandlers{
+			RoomServices: services.Room{
+				RoomRepositories: repositories.Room{
+					Db: db,
+				},
+			},
+		}
+
+		r.MethodFunc("post", "/", roomHandlers.CreateRoom)
+		r.MethodFunc("get", "/", roomHandlers.GetRooms)
+		r.MethodFunc("get", "/free", roomHandlers.GetFreeRooms)
+		r.MethodFunc("get", "/room/{id}", roomHandlers.GetRoomByID)
+		r.MethodFunc("put", "/room/{id}", roomHandlers.UpdateRoom)
+		r.MethodFunc("delete", "/room/{id}", roomHandlers.DeleteRoom)
+	})
+
+	r.Route("/departments", func(r chi.Router) {
+		departmentHandlers := handlers.DepartmentHandlers{
+			DepartmentServices: services.Department{
+				DepartmentRepositories: repositories.Department{
+					Db: db,
+				},
+			},
+		}
+
+		r.MethodFunc("post", "/", departmentHandlers.CreateDepartment)
+		r.MethodFunc("get", "/", departmentHandlers.GetDepartments)
+		r.MethodFunc("get", "/department/{id}", departmentHandlers.GetDepartmentByID)
+		r.MethodFunc("put", "/department/{id}", departmentHandlers.UpdateDepartment)
+		r.MethodFunc("delete", "/department/{id}", departmentHandlers.DeleteDepartment)
+	})
+
+	r.Route("/programs", func(r chi.Router) {
+		programHandlers := handlers.ProgramHandlers{
+			ProgramServices: services.Program{
+				ProgramRepositories: repositories.Program{
+					Db: db,
+				},
+				DepartmentRepositories: repositories.Department{
+					Db: db,
+				},
+				GradeScale: models.DefaultGradeScale,
+			},
+		}
+
+		r.MethodFunc("post", "/", programHandlers.CreateProgram)
+		r.MethodFunc("get", "/", programHandlers.GetPrograms)
+		r.MethodFunc("get", "/program/{id}", programHandlers.GetProgramByID)
+		r.MethodFunc("put", "/program/{id}", programHandlers.UpdateProgram)
+		r.MethodFunc("delete", "/program/{id}", programHandlers.DeleteProgram)
+		r.MethodFunc("get", "/program/{id}/requirements", programHandlers.GetProgramRequirements)
+		r.MethodFunc("post", "/program/{id}/requirements", programHandlers.CreateProgramRequirement)
+		r.MethodFunc("put", "/program/{id}/requirements/{requirementId}", programHandlers.UpdateProgramRequirement)
+		r.MethodFunc("delete", "/program/{id}/requirements/{requirementId}", programHandlers.DeleteProgramRequirement)
+	})
+
+	r.Route("/enrollments", func(r chi.Router) {
+		enrollmentHandlers := handlers.EnrollmentHandlers{
+			EnrollmentServices: services.Enrollment{
+				EnrollmentRepositories: repositories.Enrollment{
+					Db: db,
+				},
+				StudentRepositories: repositories.Student{
+					Db: db,
+				},
 				CourseRepositories: repositories.Course{
 					Db: db,
 				},
+				MaxCreditLoad: models.DefaultMaxCreditLoad,
 			},
 		}
 
-		r.MethodFunc("post", "/", courseHandlers.CreateCourse)
-		r.MethodFunc("get", "/course/{id}", courseHandlers.GetCourseByID)
-		r.MethodFunc("delete", "/course/{id}", courseHandlers.DeleteCourse)
-		r.MethodFunc("put", "/course/{id}", courseHandlers.UpdateCourse)
+		r.MethodFunc("get", "/{id}", enrollmentHandlers.GetEnrollmentByID)
+		r.MethodFunc("patch", "/{id}/status", enrollmentHandlers.UpdateEnrollmentStatus)
+	})
+
+	// v2 lists all the teachers of a course with their roles instead of the single teacher
+	r.Route("/v2", func(r chi.Router) {
+		r.Route("/courses", func(r chi.Router) {
+			r.MethodFunc("get", "/", courseHandlers.GetCoursesV2)
+			r.MethodFunc("get", "/course/{id}", courseHandlers.GetCourseByIDV2)
+		})
 	})
 	return r
 }
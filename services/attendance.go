@@ -0,0 +1,114 @@
+package services
+
+import (
+	"math"
+	"student_rest/models"
+	"student_rest/repositories"
+	"time"
+)
+
+type Attendance struct {
+	repositories.AttendanceRepositories
+	repositories.CourseRepositories
+}
+
+type AttendanceServices interface {
+	MarkRoster(courseID string, roster *models.MarkRosterModel) ([]*repositories.AttendanceEntity, error)
+	GetCourseAttendance(courseID string) (*repositories.CourseAttendanceEntity, error)
+	GetStudentAttendance(studentID string) (*repositories.StudentAttendanceSummaryEntity, error)
+}
+
+func (_self Attendance) MarkRoster(courseID string, roster *models.MarkRosterModel) ([]*repositories.AttendanceEntity, error) {
+	course, err := getCourse(_self.CourseRepositories, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkSessionInCourse(course, roster.SessionDate); err != nil {
+		return nil, err
+	}
+
+	// Once the course has a meeting pattern attendance is only taken at its sessions
+	sessions, err := _self.CourseRepositories.GetCourseSessions(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) > 0 && !hasSessionOn(sessions, roster.SessionDate) {
+		return nil, models.ErrSessionNotScheduled
+	}
+	return _self.AttendanceRepositories.MarkRoster(course.ID, roster)
+}
+
+// checkSessionInCourse checks the session date falls between the first and the last day of the course
+func checkSessionInCourse(course *models.CourseModel, sessionDate string) error {
+	startTime, err := time.Parse(time.RFC3339, course.StartTime)
+	if err != nil {
+		return err
+	}
+	endTime, err := time.Parse(time.RFC3339, course.EndTime)
+	if err != nil {
+		return err
+	}
+
+	// Dates in YYYY-MM-DD form compare in calendar order
+	if sessionDate < startTime.Format("2006-01-02") || sessionDate > endTime.Format("2006-01-02") {
+		return models.ErrSessionOutsideCourse
+	}
+	return nil
+}
+
+// GetCourseAttendance summarizes the attendance per enrolled student and over the whole roster
+func (_self Attendance) GetCourseAttendance(courseID string) (*repositories.CourseAttendanceEntity, error) {
+	course, err := getCourse(_self.CourseRepositories, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendance, err := _self.AttendanceRepositories.GetCourseAttendance(course.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendance.Total = &repositories.AttendanceSummaryEntity{}
+	for _, student := range attendance.Students {
+		student.Attendance.Rate = attendanceRate(student.Attendance)
+		addAttendance(attendance.Total, student.Attendance)
+	}
+	attendance.Total.Rate = attendanceRate(attendance.Total)
+	return attendance, nil
+}
+
+// GetStudentAttendance summarizes the attendance of the student per course and over all their courses
+func (_self Attendance) GetStudentAttendance(studentID string) (*repositories.StudentAttendanceSummaryEntity, error) {
+	attendance, err := _self.AttendanceRepositories.GetStudentAttendance(studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendance.Total = &repositories.AttendanceSummaryEntity{}
+	for _, course := range attendance.Courses {
+		course.Attendance.Rate = attendanceRate(course.Attendance)
+		addAttendance(attendance.Total, course.Attendance)
+	}
+	attendance.Total.Rate = attendanceRate(attendance.Total)
+	return attendance, nil
+}
+
+func addAttendance(total *repositories.AttendanceSummaryEntity, summary *repositories.AttendanceSummaryEntity) {
+	total.Sessions += summary.Sessions
+	total.Present += summary.Present
+	total.Absent += summary.Absent
+	total.Late += summary.Late
+	total.Excused += summary.Excused
+}
+
+// attendanceRate is the percentage of sessions attended, late counts as attended and excused sessions aren't counted.
+// It is nil while there is no session to count.
+func attendanceRate(summary *repositories.AttendanceSummaryEntity) *float64 {
+	counted := summary.Sessions - summary.Excused
+	if counted == 0 {
+		return nil
+	}
+	rate := math.Round(float64(summary.Present+summary.Late)/float64(counted)*10000) / 100
+	return &rate
+}
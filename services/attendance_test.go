@@ -0,0 +1,198 @@
+package services
+
+import (
+	"database/sql"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockAttendanceRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttendanceRepository) MarkRoster(courseID int, roster *models.MarkRosterModel) ([]*repositories.AttendanceEntity, error) {
+	returnArgs := m.Called(courseID, roster)
+	return returnArgs.Get(0).([]*repositories.AttendanceEntity), returnArgs.Error(1)
+}
+
+func (m *MockAttendanceRepository) GetCourseAttendance(courseID int) (*repositories.CourseAttendanceEntity, error) {
+	returnArgs := m.Called(courseID)
+	return returnArgs.Get(0).(*repositories.CourseAttendanceEntity), returnArgs.Error(1)
+}
+
+func (m *MockAttendanceRepository) GetStudentAttendance(studentID string) (*repositories.StudentAttendanceSummaryEntity, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).(*repositories.StudentAttendanceSummaryEntity), returnArgs.Error(1)
+}
+
+func Test_MarkRoster(t *testing.T) {
+	roster := func(sessionDate string) *models.MarkRosterModel {
+		return &models.MarkRosterModel{
+			SessionDate: sessionDate,
+			RecordedBy:  "teacher@school.edu",
+			Records: []*models.AttendanceRecordModel{
+				{StudentID: 1, Status: models.AttendanceStatusPresent},
+			},
+		}
+	}
+	mathCourse := &models.CourseModel{
+		ID:        1,
+		Name:      "Math",
+		StartTime: "2020-11-02T09:00:00Z",
+		EndTime:   "2020-11-30T11:00:00Z",
+	}
+
+	testCases := []struct {
+		name             string
+		inputCourseID    string
+		inputRoster      *models.MarkRosterModel
+		expectedValue    []*repositories.AttendanceEntity
+		expectedError    error
+		mockCourseResult *models.CourseModel
+		mockCourseError  error
+		mockSessions     []*repositories.SessionEntity
+		mockRepoResult   []*repositories.AttendanceEntity
+		mockRepoError    error
+	}{
+		{
+			name:             "course not found",
+			inputCourseID:    "9",
+			inputRoster:      roster("2020-11-02"),
+			expectedValue:    nil,
+			expectedError:    models.ErrCourseNotFound,
+			mockCourseResult: nil,
+			mockCourseError:  sql.ErrNoRows,
+		},
+		{
+			name:             "session before the course starts",
+			inputCourseID:    "1",
+			inputRoster:      roster("2020-11-01"),
+			expectedValue:    nil,
+			expectedError:    models.ErrSessionOutsideCourse,
+			mockCourseResult: mathCourse,
+		},
+		{
+			name:             "session after the course ends",
+			inputCourseID:    "1",
+			inputRoster:      roster("2020-12-01"),
+			expectedValue:    nil,
+			expectedError:    models.ErrSessionOutsideCourse,
+			mockCourseResult: mathCourse,
+		},
+		{
+			name:             "no session on the date",
+			inputCourseID:    "1",
+			inputRoster:      roster("2020-11-03"),
+			expectedValue:    nil,
+			expectedError:    models.ErrSessionNotScheduled,
+			mockCourseResult: mathCourse,
+			mockSessions: []*repositories.SessionEntity{
+				{ID: 1, CourseID: 1, Date: "2020-11-02"},
+				{ID: 2, CourseID: 1, Date: "2020-11-04"},
+			},
+		},
+		{
+			name:             "student not enrolled",
+			inputCourseID:    "1",
+			inputRoster:      roster("2020-11-30"),
+			expectedValue:    nil,
+			expectedError:    &models.StudentsNotEnrolledError{StudentIDs: []int{1}},
+			mockCourseResult: mathCourse,
+			mockRepoResult:   nil,
+			mockRepoError:    &models.StudentsNotEnrolledError{StudentIDs: []int{1}},
+		},
+		{
+			name:          "mark roster successfully",
+			inputCourseID: "1",
+			inputRoster:   roster("2020-11-02"),
+			expectedValue: []*repositories.AttendanceEntity{
+				{ID: 1, EnrollmentID: 1, StudentID: 1, SessionDate: "2020-11-02T00:00:00Z", Status: models.AttendanceStatusPresent},
+			},
+			expectedError:    nil,
+			mockCourseResult: mathCourse,
+			mockSessions: []*repositories.SessionEntity{
+				{ID: 1, CourseID: 1, Date: "2020-11-02"},
+				{ID: 2, CourseID: 1, Date: "2020-11-04"},
+			},
+			mockRepoResult: []*repositories.AttendanceEntity{
+				{ID: 1, EnrollmentID: 1, StudentID: 1, SessionDate: "2020-11-02T00:00:00Z", Status: models.AttendanceStatusPresent},
+			},
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockCourseRepo := new(MocCourseRepository)
+			mockCourseRepo.On("GetCourseByID", testCase.inputCourseID).Return(testCase.mockCourseResult, testCase.mockCourseError)
+			mockCourseRepo.On("GetCourseSessions", testCase.inputCourseID).Return(testCase.mockSessions, nil)
+			mockRepo := new(MockAttendanceRepository)
+			mockRepo.On("MarkRoster", 1, testCase.inputRoster).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			attendanceService := Attendance{
+				AttendanceRepositories: mockRepo,
+				CourseRepositories:     mockCourseRepo,
+			}
+
+			result, err := attendanceService.MarkRoster(testCase.inputCourseID, testCase.inputRoster)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetCourseAttendance(t *testing.T) {
+	rate := func(rate float64) *float64 {
+		return &rate
+	}
+
+	mockCourseRepo := new(MocCourseRepository)
+	mockCourseRepo.On("GetCourseByID", "1").Return(&models.CourseModel{ID: 1, Name: "Math"}, nil)
+	mockRepo := new(MockAttendanceRepository)
+	mockRepo.On("GetCourseAttendance", 1).Return(&repositories.CourseAttendanceEntity{
+		CourseID:     1,
+		SessionsHeld: 3,
+		Students: []*repositories.StudentAttendanceEntity{
+			{
+				Student:    &repositories.StudentEntity{ID: 1},
+				Attendance: &repositories.AttendanceSummaryEntity{Sessions: 3, Present: 1, Late: 1, Absent: 1},
+			},
+			{
+				Student:    &repositories.StudentEntity{ID: 2},
+				Attendance: &repositories.AttendanceSummaryEntity{Sessions: 3, Present: 2, Excused: 1},
+			},
+			{
+				Student:    &repositories.StudentEntity{ID: 3},
+				Attendance: &repositories.AttendanceSummaryEntity{},
+			},
+		},
+	}, nil)
+
+	attendanceService := Attendance{
+		AttendanceRepositories: mockRepo,
+		CourseRepositories:     mockCourseRepo,
+	}
+
+	result, err := attendanceService.GetCourseAttendance("1")
+
+	require.NoError(t, err)
+	require.Equal(t, rate(66.67), result.Students[0].Attendance.Rate)
+	require.Equal(t, rate(100), result.Students[1].Attendance.Rate)
+	require.Nil(t, result.Students[2].Attendance.Rate)
+	require.Equal(t, &repositories.AttendanceSummaryEntity{
+		Sessions: 6,
+		Present:  3,
+		Absent:   1,
+		Late:     1,
+		Excused:  1,
+		Rate:     rate(80),
+	}, result.Total)
+}
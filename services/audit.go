@@ -0,0 +1,180 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+)
+
+// auditRanks orders the audit statuses, a course taken several times counts with its best enrollment
+var auditRanks = map[string]int{
+	models.AuditStatusMissing:    0,
+	models.AuditStatusInProgress: 1,
+	models.AuditStatusSatisfied:  2,
+}
+
+// GetStudentAudit checks the course history of the student against the requirements of their program
+func (_self Student) GetStudentAudit(studentID string) (*repositories.AuditEntity, error) {
+	student, err := _self.StudentRepositories.GetStudentByID(studentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if student.ProgramID == nil {
+		return nil, models.ErrStudentHasNoProgram
+	}
+
+	programID := strconv.Itoa(*student.ProgramID)
+	program, err := _self.ProgramRepositories.GetProgramByID(programID)
+	if err != nil {
+		return nil, err
+	}
+	requirements, err := _self.ProgramRepositories.GetProgramRequirements(programID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := _self.StudentRepositories.GetCourseHistory(studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	audit := &repositories.AuditEntity{
+		Student:    student,
+		Program:    program,
+		Satisfied:  []*repositories.RequirementAuditEntity{},
+		InProgress: []*repositories.RequirementAuditEntity{},
+		Missing:    []*repositories.RequirementAuditEntity{},
+	}
+	for _, requirement := range requirements {
+		result, err := auditRequirement(_self.GradeScale, requirement, history)
+		if err != nil {
+			return nil, err
+		}
+
+		switch result.Status {
+		case models.AuditStatusSatisfied:
+			audit.Satisfied = append(audit.Satisfied, result)
+		case models.AuditStatusInProgress:
+			audit.InProgress = append(audit.InProgress, result)
+		default:
+			audit.Missing = append(audit.Missing, result)
+		}
+	}
+	return audit, nil
+}
+
+// auditRequirement checks one requirement. A courses requirement is satisfied once every course is, and in progress
+// when none is missing. A credits requirement counts the credits of the satisfied courses, with the credits of the
+// courses in progress it is in progress.
+func auditRequirement(scale *models.GradeScaleModel, requirement *repositories.ProgramRequirementEntity,
+	history []*repositories.CourseHistoryEntity) (*repositories.RequirementAuditEntity, error) {
+	result := &repositories.RequirementAuditEntity{
+		ID:              requirement.ID,
+		Name:            requirement.Name,
+		Kind:            requirement.Kind,
+		MinGrade:        requirement.MinGrade,
+		RequiredCredits: requirement.Credits,
+		Courses:         make([]*repositories.CourseAuditEntity, 0, len(requirement.Courses)),
+	}
+
+	lowest := models.AuditStatusSatisfied
+	for _, course := range requirement.Courses {
+		courseAudit, err := auditCourse(scale, requirement.MinGrade, course, history)
+		if err != nil {
+			return nil, err
+		}
+		result.Courses = append(result.Courses, courseAudit)
+
+		switch courseAudit.Status {
+		case models.AuditStatusSatisfied:
+			result.EarnedCredits += course.Credits
+		case models.AuditStatusInProgress:
+			result.InProgressCredits += course.Credits
+		}
+		if auditRanks[courseAudit.Status] < auditRanks[lowest] {
+			lowest = courseAudit.Status
+		}
+	}
+
+	if requirement.Kind != models.RequirementKindCredits {
+		result.Status = lowest
+		return result, nil
+	}
+
+	switch {
+	case result.EarnedCredits >= *requirement.Credits:
+		result.Status = models.AuditStatusSatisfied
+	case result.EarnedCredits+result.InProgressCredits >= *requirement.Credits:
+		result.Status = models.AuditStatusInProgress
+	default:
+		result.Status = models.AuditStatusMissing
+	}
+	return result, nil
+}
+
+// auditCourse finds how far the student got with the course over all their enrollments in it
+func auditCourse(scale *models.GradeScaleModel, minGrade *string, course *repositories.RequirementCourseEntity,
+	history []*repositories.CourseHistoryEntity) (*repositories.CourseAuditEntity, error) {
+	result := &repositories.CourseAuditEntity{
+		CourseID:   course.ID,
+		CourseName: course.Name,
+		Credits:    course.Credits,
+		Status:     models.AuditStatusMissing,
+	}
+
+	for _, enrollment := range history {
+		if enrollment.CourseID != course.ID {
+			continue
+		}
+		status, err := enrollmentAuditStatus(scale, minGrade, enrollment)
+		if err != nil {
+			return nil, err
+		}
+		if auditRanks[status] > auditRanks[result.Status] {
+			result.Status = status
+			result.GradeLetter = enrollment.GradeLetter
+			result.GradeScore = enrollment.GradeScore
+		}
+	}
+	return result, nil
+}
+
+// enrollmentAuditStatus says whether an enrollment counts for a requirement. A completed course counts once its grade
+// reaches the minimum grade, or is a passing grade when there is no minimum. Until it is graded it is in progress
+// like an active course. Other enrollments don't count.
+func enrollmentAuditStatus(scale *models.GradeScaleModel, minGrade *string, enrollment *repositories.CourseHistoryEntity) (string, error) {
+	switch enrollment.Status {
+	case models.EnrollmentStatusActive:
+		return models.AuditStatusInProgress, nil
+	case models.EnrollmentStatusCompleted:
+	default:
+		return models.AuditStatusMissing, nil
+	}
+
+	if enrollment.GradeLetter == nil && enrollment.GradeScore == nil {
+		return models.AuditStatusInProgress, nil
+	}
+	points, err := gradePoints(scale, &models.GradeModel{Letter: enrollment.GradeLetter, Score: enrollment.GradeScore})
+	if err != nil {
+		return "", err
+	}
+
+	if minGrade == nil {
+		if points > 0 {
+			return models.AuditStatusSatisfied, nil
+		}
+		return models.AuditStatusMissing, nil
+	}
+	minPoints, ok := scale.Letters[*minGrade]
+	if !ok {
+		return "", models.ErrInvalidGrade
+	}
+	if points < minPoints {
+		return models.AuditStatusMissing, nil
+	}
+	return models.AuditStatusSatisfied, nil
+}
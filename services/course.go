@@ -1,22 +1,66 @@
 package services
 
 import (
+	"errors"
+	"strconv"
+	"strings"
 	"student_rest/models"
 	"student_rest/repositories"
 )
 
 type Course struct {
 	repositories.CourseRepositories
+	StudentRepositories    repositories.StudentRepositories
+	TermRepositories       repositories.TermRepositories
+	TeacherRepositories    repositories.TeacherRepositories
+	RoomRepositories       repositories.RoomRepositories
+	DepartmentRepositories repositories.DepartmentRepositories
+	GradeScale             *models.GradeScaleModel
+	MaxCreditLoad          int
+	TeacherWorkload        *models.TeacherWorkloadModel
 }
 
 type CourseServices interface {
 	CreateCourse(course *models.CourseModel) (*models.CourseModel, error)
 	GetCourseByID(id string) (*models.CourseModel, error)
+	GetCourses(filter *models.CourseFilterModel) ([]*models.CourseModel, error)
 	DeleteCourse(id string) error
 	UpdateCourse(id string, course *models.CourseModel) error
+	GetCourseStudents(id string, filter *models.RosterFilterModel) ([]*repositories.StudentEntity, int, error)
+	GetPrerequisites(id string) ([]*models.CourseModel, error)
+	AddPrerequisite(id string, prerequisiteID string) error
+	DeletePrerequisite(id string, prerequisiteID string) error
+	BulkEnrollCourse(id string, bulk *models.BulkEnrollmentModel) ([]*repositories.BulkEnrollmentResultEntity, error)
+	SetRegistrationOverride(id string, studentID string, grantedBy string) error
+	DeleteRegistrationOverride(id string, studentID string) error
+	RecordGrade(id string, studentID string, grade *models.RecordGradeModel) (*repositories.EnrollmentEntity, error)
+	SetMeetingPattern(id string, pattern *models.MeetingPatternModel) (*repositories.MeetingPatternEntity, error)
+	GetMeetingPattern(id string) (*repositories.MeetingPatternEntity, error)
+	DeleteMeetingPattern(id string) error
+	GetCourseSessions(id string) ([]*repositories.SessionEntity, error)
+	GetCourseWithTeachers(id string) (*models.CourseModel, error)
+	GetCoursesWithTeachers(filter *models.CourseFilterModel) ([]*models.CourseModel, error)
+	GetCourseTeachers(id string) ([]*models.CourseTeacherModel, error)
+	SetCourseTeacher(id string, teacherID string, role string) error
+	DeleteCourseTeacher(id string, teacherID string) error
+	GetCourseRooms(id string) ([]*repositories.CourseRoomEntity, error)
+	BookRoom(id string, date string, roomID int) error
+	DeleteRoomBooking(id string, date string) error
 }
 
 func (_self Course) CreateCourse(course *models.CourseModel) (*models.CourseModel, error) {
+	if err := checkCourseInTerm(_self.TermRepositories, course); err != nil {
+		return nil, err
+	}
+	if err := checkDepartment(_self.DepartmentRepositories, course.DepartmentID); err != nil {
+		return nil, err
+	}
+
+	// A new course has no meeting pattern yet, so it has no sessions to overlap and no weekly hours
+	if err := _self.teacherRules().check("", course, nil, 0); err != nil {
+		return nil, err
+	}
+
 	convertedCourse := transformCourseModelToCourseEntity(*course)
 	result, err := _self.CourseRepositories.CreateCourse(&convertedCourse)
 	if err != nil {
@@ -31,7 +75,15 @@ func transformCourseModelToCourseEntity(model models.CourseModel) repositories.C
 		Name:      model.Name,
 		StartTime: model.StartTime,
 		EndTime:   model.EndTime,
-		TeacherID: model.Teacher.ID,
+		Capacity:  model.Capacity,
+		Credits:   model.Credits,
+
+		RegistrationOpensAt:  model.RegistrationOpensAt,
+		RegistrationClosesAt: model.RegistrationClosesAt,
+
+		TermID:       model.Term.ID,
+		TeacherID:    model.Teacher.ID,
+		DepartmentID: model.DepartmentID,
 	}
 }
 
@@ -40,13 +92,406 @@ func (_self Course) GetCourseByID(id string) (*models.CourseModel, error) {
 	return result, err
 }
 
+func (_self Course) GetCourses(filter *models.CourseFilterModel) ([]*models.CourseModel, error) {
+	return _self.CourseRepositories.GetCourses(filter)
+}
+
 func (_self Course) DeleteCourse(id string) error {
 	err := _self.CourseRepositories.DeleteCourse(id)
 	return err
 }
 
+// UpdateCourse updates the course and regenerates its sessions when it has a meeting pattern
 func (_self Course) UpdateCourse(id string, course *models.CourseModel) error {
+	if err := checkCourseInTerm(_self.TermRepositories, course); err != nil {
+		return err
+	}
+	if err := checkDepartment(_self.DepartmentRepositories, course.DepartmentID); err != nil {
+		return err
+	}
+
+	// Generate the sessions first so new dates without any session are rejected before the course changes
+	pattern, err := _self.CourseRepositories.GetMeetingPattern(id)
+	if err != nil && !errors.Is(err, models.ErrMeetingPatternNotFound) {
+		return err
+	}
+	var sessions []*repositories.SessionEntity
+	if pattern != nil {
+		if sessions, err = generateSessions(course, pattern); err != nil {
+			return err
+		}
+	}
+
+	if err = _self.teacherRules().check(id, course, sessions, patternWeeklyMinutes(pattern)); err != nil {
+		return err
+	}
+	if err = _self.roomRules().checkCourse(id, course, sessions); err != nil {
+		return err
+	}
+
 	convertedCourse := transformCourseModelToCourseEntity(*course)
-	err := _self.CourseRepositories.UpdateCourse(id, &convertedCourse)
+	return _self.CourseRepositories.UpdateCourse(id, &convertedCourse, sessions, _self.MaxCreditLoad)
+}
+
+func (_self Course) GetCourseStudents(id string, filter *models.RosterFilterModel) ([]*repositories.StudentEntity, int, error) {
+	result, total, err := _self.CourseRepositories.GetCourseStudents(id, filter)
+	return result, total, err
+}
+
+func (_self Course) GetPrerequisites(id string) ([]*models.CourseModel, error) {
+	result, err := _self.CourseRepositories.GetPrerequisites(id)
+	return result, err
+}
+
+// AddPrerequisite makes prerequisiteID a prerequisite of the course, the repository refuses a prerequisite that already requires the course
+func (_self Course) AddPrerequisite(id string, prerequisiteID string) error {
+	courseID, err := strconv.Atoi(id)
+	if err != nil {
+		return models.ErrCourseNotFound
+	}
+	requiredID, err := strconv.Atoi(prerequisiteID)
+	if err != nil {
+		return models.ErrCourseNotFound
+	}
+	if courseID == requiredID {
+		return models.ErrPrerequisiteCycle
+	}
+
+	err = _self.CourseRepositories.AddPrerequisite(id, prerequisiteID)
 	return err
 }
+
+func (_self Course) DeletePrerequisite(id string, prerequisiteID string) error {
+	err := _self.CourseRepositories.DeletePrerequisite(id, prerequisiteID)
+	return err
+}
+
+func (_self Course) SetRegistrationOverride(id string, studentID string, grantedBy string) error {
+	err := _self.CourseRepositories.SetRegistrationOverride(id, studentID, grantedBy)
+	return err
+}
+
+func (_self Course) DeleteRegistrationOverride(id string, studentID string) error {
+	err := _self.CourseRepositories.DeleteRegistrationOverride(id, studentID)
+	return err
+}
+
+// BulkEnrollCourse runs the enrollment rules for every student and enrolls the eligible ones in one transaction
+func (_self Course) BulkEnrollCourse(id string, bulk *models.BulkEnrollmentModel) ([]*repositories.BulkEnrollmentResultEntity, error) {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*repositories.BulkEnrollmentResultEntity{}
+	for _, studentID := range bulk.StudentIDs {
+		results = append(results, &repositories.BulkEnrollmentResultEntity{StudentID: studentID})
+	}
+	for _, code := range bulk.StudentCodes {
+		result := &repositories.BulkEnrollmentResultEntity{StudentCode: code}
+		student, err := _self.StudentRepositories.GetStudentByCode(code)
+		if err != nil {
+			result.Fail(err)
+		} else {
+			result.StudentID = student.ID
+		}
+		results = append(results, result)
+	}
+
+	rules := enrollmentRules{
+		StudentRepositories: _self.StudentRepositories,
+		CourseRepositories:  _self.CourseRepositories,
+	}
+	eligible := []*repositories.BulkEnrollmentResultEntity{}
+	for _, result := range results {
+		if result.Error != "" {
+			continue
+		}
+		if err := rules.check(strconv.Itoa(result.StudentID), course, bulk.Options); err != nil {
+			result.Fail(err)
+			continue
+		}
+		eligible = append(eligible, result)
+	}
+
+	if len(eligible) < len(results) && bulk.Mode == models.BulkEnrollmentAllOrNothing {
+		for _, result := range eligible {
+			result.Fail(models.ErrBulkEnrollmentRolledBack)
+		}
+		return results, nil
+	}
+	if len(eligible) == 0 {
+		return results, nil
+	}
+
+	studentIDs := make([]int, 0, len(eligible))
+	for _, result := range eligible {
+		studentIDs = append(studentIDs, result.StudentID)
+	}
+	enrolled, err := _self.CourseRepositories.BulkEnrollCourse(id, studentIDs, bulk.Mode, bulk.Options, _self.MaxCreditLoad)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, result := range eligible {
+		result.Success = enrolled[i].Success
+		result.Enrollment = enrolled[i].Enrollment
+		result.Error = enrolled[i].Error
+		result.Code = enrolled[i].Code
+		result.Details = enrolled[i].Details
+	}
+	return results, nil
+}
+
+func (_self Course) RecordGrade(id string, studentID string, grade *models.RecordGradeModel) (*repositories.EnrollmentEntity, error) {
+	return recordGrade(_self.StudentRepositories, _self.GradeScale, studentID, id, grade)
+}
+
+// SetMeetingPattern saves the meeting pattern and replaces the course sessions with the ones generated from it
+func (_self Course) SetMeetingPattern(id string, pattern *models.MeetingPatternModel) (*repositories.MeetingPatternEntity, error) {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return nil, err
+	}
+
+	convertedPattern := transformMeetingPatternModelToMeetingPatternEntity(course.ID, pattern)
+	sessions, err := generateSessions(course, convertedPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// New sessions can overlap the other courses of the teacher or add to their weekly hours
+	if err = _self.teacherRules().check(id, course, sessions, patternWeeklyMinutes(convertedPattern)); err != nil {
+		return nil, err
+	}
+	// and the other bookings of the rooms of the course
+	if err = _self.roomRules().checkCourse(id, course, sessions); err != nil {
+		return nil, err
+	}
+
+	convertedPattern.Sessions, err = _self.CourseRepositories.SetMeetingPattern(convertedPattern, sessions)
+	if err != nil {
+		return nil, err
+	}
+	return convertedPattern, nil
+}
+
+func transformMeetingPatternModelToMeetingPatternEntity(courseID int, model *models.MeetingPatternModel) *repositories.MeetingPatternEntity {
+	days := make([]string, 0, len(model.DaysOfWeek))
+	for _, day := range model.DaysOfWeek {
+		days = append(days, strings.ToLower(day))
+	}
+	excludedDates := model.ExcludedDates
+	if excludedDates == nil {
+		excludedDates = []string{}
+	}
+	return &repositories.MeetingPatternEntity{
+		CourseID:        courseID,
+		DaysOfWeek:      days,
+		StartTime:       model.StartTime,
+		DurationMinutes: model.DurationMinutes,
+		TimeZone:        model.TimeZone,
+		ExcludedDates:   excludedDates,
+	}
+}
+
+func (_self Course) GetMeetingPattern(id string) (*repositories.MeetingPatternEntity, error) {
+	return _self.CourseRepositories.GetMeetingPattern(id)
+}
+
+func (_self Course) DeleteMeetingPattern(id string) error {
+	return _self.CourseRepositories.DeleteMeetingPattern(id)
+}
+
+func (_self Course) GetCourseSessions(id string) ([]*repositories.SessionEntity, error) {
+	if _, err := getCourse(_self.CourseRepositories, id); err != nil {
+		return nil, err
+	}
+	return _self.CourseRepositories.GetCourseSessions(id)
+}
+
+// GetCourseWithTeachers gets the course with all of its teachers
+func (_self Course) GetCourseWithTeachers(id string) (*models.CourseModel, error) {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = _self.fillTeachers([]*models.CourseModel{course}); err != nil {
+		return nil, err
+	}
+	return course, nil
+}
+
+// GetCoursesWithTeachers lists the courses with all of their teachers
+func (_self Course) GetCoursesWithTeachers(filter *models.CourseFilterModel) ([]*models.CourseModel, error) {
+	courses, err := _self.CourseRepositories.GetCourses(filter)
+	if err != nil {
+		return nil, err
+	}
+	if err = _self.fillTeachers(courses); err != nil {
+		return nil, err
+	}
+	return courses, nil
+}
+
+func (_self Course) fillTeachers(courses []*models.CourseModel) error {
+	if len(courses) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(courses))
+	for _, course := range courses {
+		ids = append(ids, course.ID)
+	}
+	teachers, err := _self.CourseRepositories.GetCourseTeachers(ids)
+	if err != nil {
+		return err
+	}
+	for _, course := range courses {
+		course.Teachers = teachers[course.ID]
+	}
+	return nil
+}
+
+func (_self Course) GetCourseTeachers(id string) ([]*models.CourseTeacherModel, error) {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return nil, err
+	}
+	teachers, err := _self.CourseRepositories.GetCourseTeachers([]int{course.ID})
+	if err != nil {
+		return nil, err
+	}
+	return teachers[course.ID], nil
+}
+
+// SetCourseTeacher adds an active teacher to the course or changes their role, the primary teacher stays the lead.
+// The course must fit the schedule and the workload of the teacher like a course they are the primary teacher of.
+func (_self Course) SetCourseTeacher(id string, teacherID string, role string) error {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return err
+	}
+	teacher, err := strconv.Atoi(teacherID)
+	if err != nil {
+		return models.ErrTeacherNotFound
+	}
+	if course.Teacher != nil && course.Teacher.ID == teacher {
+		return models.ErrPrimaryTeacher
+	}
+
+	sessions, err := _self.CourseRepositories.GetCourseSessions(id)
+	if err != nil {
+		return err
+	}
+	pattern, err := _self.CourseRepositories.GetMeetingPattern(id)
+	if err != nil && !errors.Is(err, models.ErrMeetingPatternNotFound) {
+		return err
+	}
+	taught := *course
+	taught.Teacher = &models.TeacherModel{ID: teacher}
+	if err = _self.teacherRules().check(id, &taught, sessions, patternWeeklyMinutes(pattern)); err != nil {
+		return err
+	}
+	return _self.CourseRepositories.SetCourseTeacher(course.ID, teacher, role)
+}
+
+func (_self Course) DeleteCourseTeacher(id string, teacherID string) error {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return err
+	}
+	if course.Teacher != nil && strconv.Itoa(course.Teacher.ID) == teacherID {
+		return models.ErrPrimaryTeacher
+	}
+	return _self.CourseRepositories.DeleteCourseTeacher(course.ID, teacherID)
+}
+
+func (_self Course) teacherRules() teacherAssignmentRules {
+	return teacherAssignmentRules{
+		CourseRepositories:  _self.CourseRepositories,
+		TeacherRepositories: _self.TeacherRepositories,
+		TeacherWorkload:     _self.TeacherWorkload,
+	}
+}
+
+func (_self Course) GetCourseRooms(id string) ([]*repositories.CourseRoomEntity, error) {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return nil, err
+	}
+	return _self.RoomRepositories.GetCourseRooms(course.ID)
+}
+
+// BookRoom books the room for all the sessions of the course, or only for its session on date when date isn't empty.
+// The room must seat the capacity of the course and the students enrolled in it, and be free at the time of the sessions.
+func (_self Course) BookRoom(id string, date string, roomID int) error {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return err
+	}
+	room, err := _self.RoomRepositories.GetRoomByID(strconv.Itoa(roomID))
+	if err != nil {
+		return err
+	}
+
+	sessions, err := _self.CourseRepositories.GetCourseSessions(id)
+	if err != nil {
+		return err
+	}
+	var sessionDate *string
+	if date != "" {
+		if !hasSessionOn(sessions, date) {
+			return models.ErrSessionNotScheduled
+		}
+		sessionDate = &date
+	}
+
+	rules := _self.roomRules()
+	if err = rules.checkCapacity(id, course, room); err != nil {
+		return err
+	}
+
+	bookings, err := _self.RoomRepositories.GetCourseRooms(course.ID)
+	if err != nil {
+		return err
+	}
+	booked := []*repositories.CourseRoomEntity{{CourseID: course.ID, Date: sessionDate, Room: room}}
+	for _, booking := range bookings {
+		if !sameBookingDate(booking.Date, sessionDate) {
+			booked = append(booked, booking)
+		}
+	}
+	if err = rules.check(course.ID, course, sessions, booked); err != nil {
+		return err
+	}
+
+	return _self.RoomRepositories.BookRoom(course.ID, room.ID, sessionDate)
+}
+
+func sameBookingDate(date *string, other *string) bool {
+	if date == nil || other == nil {
+		return date == nil && other == nil
+	}
+	return *date == *other
+}
+
+// DeleteRoomBooking cancels the room of the whole course, or only the room of its session on date when date isn't empty
+func (_self Course) DeleteRoomBooking(id string, date string) error {
+	course, err := getCourse(_self.CourseRepositories, id)
+	if err != nil {
+		return err
+	}
+	var sessionDate *string
+	if date != "" {
+		sessionDate = &date
+	}
+	return _self.RoomRepositories.DeleteRoomBooking(course.ID, sessionDate)
+}
+
+func (_self Course) roomRules() roomBookingRules {
+	return roomBookingRules{
+		CourseRepositories: _self.CourseRepositories,
+		RoomRepositories:   _self.RoomRepositories,
+	}
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"database/sql"
 	"errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -23,29 +24,176 @@ func (m *MocCourseRepository) GetCourseByID(id string) (*models.CourseModel, err
 	return returnArgs.Get(0).(*models.CourseModel), returnArgs.Error(1)
 }
 
+func (m *MocCourseRepository) GetCourses(filter *models.CourseFilterModel) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
 func (m *MocCourseRepository) DeleteCourse(id string) error {
 	returnArgs := m.Called(id)
 	return returnArgs.Error(0)
 }
 
-func (m *MocCourseRepository) UpdateCourse(id string, course *repositories.CourseEntity) error {
-	returnArgs := m.Called(id, course)
+func (m *MocCourseRepository) UpdateCourse(id string, course *repositories.CourseEntity, sessions []*repositories.SessionEntity, maxCreditLoad int) error {
+	returnArgs := m.Called(id, course, sessions, maxCreditLoad)
+	return returnArgs.Error(0)
+}
+
+func (m *MocCourseRepository) GetCourseStudents(id string, filter *models.RosterFilterModel) ([]*repositories.StudentEntity, int, error) {
+	returnArgs := m.Called(id, filter)
+	return returnArgs.Get(0).([]*repositories.StudentEntity), returnArgs.Int(1), returnArgs.Error(2)
+}
+
+func (m *MocCourseRepository) GetPrerequisites(id string) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
+func (m *MocCourseRepository) AddPrerequisite(id string, prerequisiteID string) error {
+	returnArgs := m.Called(id, prerequisiteID)
+	return returnArgs.Error(0)
+}
+
+func (m *MocCourseRepository) DeletePrerequisite(id string, prerequisiteID string) error {
+	returnArgs := m.Called(id, prerequisiteID)
+	return returnArgs.Error(0)
+}
+
+func (m *MocCourseRepository) BulkEnrollCourse(id string, studentIDs []int, mode string, options *models.EnrollmentOptionsModel,
+	maxCreditLoad int) ([]*repositories.BulkEnrollmentResultEntity, error) {
+	returnArgs := m.Called(id, studentIDs, mode, options, maxCreditLoad)
+	return returnArgs.Get(0).([]*repositories.BulkEnrollmentResultEntity), returnArgs.Error(1)
+}
+
+func (m *MocCourseRepository) SetRegistrationOverride(id string, studentID string, grantedBy string) error {
+	returnArgs := m.Called(id, studentID, grantedBy)
+	return returnArgs.Error(0)
+}
+
+func (m *MocCourseRepository) DeleteRegistrationOverride(id string, studentID string) error {
+	returnArgs := m.Called(id, studentID)
+	return returnArgs.Error(0)
+}
+
+func (m *MocCourseRepository) HasRegistrationOverride(id string, studentID string) (bool, error) {
+	returnArgs := m.Called(id, studentID)
+	return returnArgs.Bool(0), returnArgs.Error(1)
+}
+
+func (m *MocCourseRepository) SetMeetingPattern(pattern *repositories.MeetingPatternEntity, sessions []*repositories.SessionEntity) ([]*repositories.SessionEntity, error) {
+	returnArgs := m.Called(pattern, sessions)
+	return returnArgs.Get(0).([]*repositories.SessionEntity), returnArgs.Error(1)
+}
+
+func (m *MocCourseRepository) GetMeetingPattern(id string) (*repositories.MeetingPatternEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.MeetingPatternEntity), returnArgs.Error(1)
+}
+
+func (m *MocCourseRepository) DeleteMeetingPattern(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+func (m *MocCourseRepository) GetCourseSessions(id string) ([]*repositories.SessionEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*repositories.SessionEntity), returnArgs.Error(1)
+}
+
+func (m *MocCourseRepository) GetEnrolledCount(id string) (int, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Int(0), returnArgs.Error(1)
+}
+
+func (m *MocCourseRepository) GetCourseTeachers(courseIDs []int) (map[int][]*models.CourseTeacherModel, error) {
+	returnArgs := m.Called(courseIDs)
+	return returnArgs.Get(0).(map[int][]*models.CourseTeacherModel), returnArgs.Error(1)
+}
+
+func (m *MocCourseRepository) SetCourseTeacher(courseID int, teacherID int, role string) error {
+	returnArgs := m.Called(courseID, teacherID, role)
+	return returnArgs.Error(0)
+}
+
+func (m *MocCourseRepository) DeleteCourseTeacher(courseID int, teacherID string) error {
+	returnArgs := m.Called(courseID, teacherID)
 	return returnArgs.Error(0)
 }
 
 func Test_CreateCourse(t *testing.T) {
+	physics := &models.CourseModel{
+		ID:        2,
+		Name:      "Physics",
+		StartTime: "2020-11-02T12:00:00Z",
+		EndTime:   "2020-11-02T14:00:00Z",
+		Term:      &models.TermModel{ID: 1, Name: "Fall 2020"},
+		Teacher:   &models.TeacherModel{ID: 1},
+	}
+
 	testCases := []struct {
-		name           string
-		input          *models.CourseModel
-		expectedValue  *models.CourseModel
-		expectedError  error
-		mockRepoInput  *repositories.CourseEntity
-		mockRepoResult *models.CourseModel
-		mockRepoError  error
+		name               string
+		input              *models.CourseModel
+		expectedValue      *models.CourseModel
+		expectedError      error
+		mockRepoInput      *repositories.CourseEntity
+		mockRepoResult     *models.CourseModel
+		mockRepoError      error
+		mockTeacherCourses []*models.CourseModel
 	}{
+		{
+			name: "course outside its term",
+			input: &models.CourseModel{
+				Name:      "Math",
+				StartTime: "2021-02-01T00:00:00Z",
+				EndTime:   "2021-02-02T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
+				Teacher: &models.TeacherModel{
+					ID: 1,
+				},
+			},
+			expectedValue: nil,
+			expectedError: &models.CourseOutsideTermError{Term: &models.TermModel{
+				ID:        1,
+				Name:      "Fall 2020",
+				StartDate: "2020-08-15T00:00:00Z",
+				EndDate:   "2020-12-31T00:00:00Z",
+				Status:    models.TermStatusActive,
+			}},
+		},
+		{
+			name: "course without sessions doesn't overlap the other courses of the teacher",
+			input: &models.CourseModel{
+				Name:      "Math",
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
+				Teacher: &models.TeacherModel{
+					ID: 1,
+				},
+			},
+			expectedValue: &models.CourseModel{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"},
+			mockRepoInput: &repositories.CourseEntity{
+				Name:      "Math",
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-03T00:00:00Z",
+				TermID:    1,
+				TeacherID: 1,
+			},
+			mockRepoResult:     &models.CourseModel{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"},
+			mockTeacherCourses: []*models.CourseModel{physics},
+		},
 		{
 			name: "create course fail",
 			input: &models.CourseModel{
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -53,6 +201,9 @@ func Test_CreateCourse(t *testing.T) {
 			expectedValue: nil,
 			expectedError: errors.New("insert course fail"),
 			mockRepoInput: &repositories.CourseEntity{
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-03T00:00:00Z",
+				TermID:    1,
 				TeacherID: 1,
 			},
 			mockRepoResult: nil,
@@ -64,6 +215,9 @@ func Test_CreateCourse(t *testing.T) {
 				Name:      "Math",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -85,6 +239,7 @@ func Test_CreateCourse(t *testing.T) {
 				Name:      "Math",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				TermID:    1,
 				TeacherID: 1,
 			},
 			mockRepoResult: &models.CourseModel{
@@ -107,9 +262,19 @@ func Test_CreateCourse(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			mockRepo := new(MocCourseRepository)
 			mockRepo.On("CreateCourse", testCase.mockRepoInput).Return(testCase.mockRepoResult, testCase.mockRepoError)
+			mockRepo.On("GetCourses", &models.CourseFilterModel{TeacherID: "1"}).Return(testCase.mockTeacherCourses, nil)
+			mockRepo.On("GetCourseSessions", "2").Return([]*repositories.SessionEntity{}, nil)
+
+			mockTermRepo := new(MockTermRepository)
+			mockTermRepo.On("GetTermByID", "1").Return(fall2020, nil)
+
+			mockTeacherRepo := new(MockTeacherRepository)
+			mockTeacherRepo.On("GetTeacherByID", "1").Return(&repositories.TeacherEntity{ID: 1}, nil)
 
 			courseService := Course{
-				CourseRepositories: mockRepo,
+				CourseRepositories:  mockRepo,
+				TermRepositories:    mockTermRepo,
+				TeacherRepositories: mockTeacherRepo,
 			}
 
 			result, err := courseService.CreateCourse(testCase.input)
@@ -242,19 +407,47 @@ func Test_DeleteCourse(t *testing.T) {
 }
 
 func Test_UpdateCourse(t *testing.T) {
+	physics := &models.CourseModel{
+		ID:        3,
+		Name:      "Physics",
+		StartTime: "2020-11-02T00:00:00Z",
+		EndTime:   "2020-11-10T00:00:00Z",
+		Term:      &models.TermModel{ID: 1, Name: "Fall 2020"},
+		Teacher:   &models.TeacherModel{ID: 1},
+	}
+	capacity := 40
+	pattern := &repositories.MeetingPatternEntity{
+		CourseID:        2,
+		DaysOfWeek:      []string{"monday"},
+		StartTime:       "09:00",
+		DurationMinutes: 90,
+		TimeZone:        "UTC",
+		ExcludedDates:   []string{},
+	}
+
 	testCases := []struct {
-		name                 string
-		inputID              string
+		name                string
+		inputID             string
 		inputCourse         *models.CourseModel
-		expectedError        error
-		mockRepoInputID      string
+		expectedError       error
+		mockRepoInputID     string
 		mockRepoInputCourse *repositories.CourseEntity
-		mockRepoError        error
+		mockRepoError       error
+		mockPattern         *repositories.MeetingPatternEntity
+		mockSessions        []*repositories.SessionEntity
+		mockTeacherCourses  []*models.CourseModel
+		mockCourseRooms     []*repositories.CourseRoomEntity
+		mockRoomBookings    []*repositories.RoomBookingEntity
 	}{
 		{
 			name:    "update course fail",
 			inputID: "1",
 			inputCourse: &models.CourseModel{
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -262,6 +455,9 @@ func Test_UpdateCourse(t *testing.T) {
 			expectedError:   errors.New("update course fail"),
 			mockRepoInputID: "1",
 			mockRepoInputCourse: &repositories.CourseEntity{
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-03T00:00:00Z",
+				TermID:    1,
 				TeacherID: 1,
 			},
 			mockRepoError: errors.New("update course fail"),
@@ -273,6 +469,9 @@ func Test_UpdateCourse(t *testing.T) {
 				Name:      "Math",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
 				Teacher: &models.TeacherModel{
 					ID: 1,
 				},
@@ -283,19 +482,158 @@ func Test_UpdateCourse(t *testing.T) {
 				Name:      "Math",
 				StartTime: "2020-11-02T00:00:00Z",
 				EndTime:   "2020-11-03T00:00:00Z",
+				TermID:    1,
 				TeacherID: 1,
 			},
 			mockRepoError: nil,
 		},
+		{
+			name:    "new dates leave no session",
+			inputID: "2",
+			inputCourse: &models.CourseModel{
+				Name:      "Math",
+				StartTime: "2020-11-03T00:00:00Z",
+				EndTime:   "2020-11-06T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
+				Teacher: &models.TeacherModel{
+					ID: 1,
+				},
+			},
+			expectedError:   models.ErrNoSessions,
+			mockRepoInputID: "2",
+			mockPattern:     pattern,
+		},
+		{
+			name:    "update course regenerates sessions",
+			inputID: "2",
+			inputCourse: &models.CourseModel{
+				Name:      "Math",
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-10T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
+				Teacher: &models.TeacherModel{
+					ID: 1,
+				},
+			},
+			expectedError:   nil,
+			mockRepoInputID: "2",
+			mockRepoInputCourse: &repositories.CourseEntity{
+				Name:      "Math",
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-10T00:00:00Z",
+				TermID:    1,
+				TeacherID: 1,
+			},
+			mockRepoError: nil,
+			mockPattern:   pattern,
+			mockSessions: []*repositories.SessionEntity{
+				{CourseID: 2, Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+				{CourseID: 2, Date: "2020-11-09", StartTime: "2020-11-09T09:00:00Z", EndTime: "2020-11-09T10:30:00Z"},
+			},
+		},
+		{
+			name:    "new sessions overlap another course of the teacher",
+			inputID: "2",
+			inputCourse: &models.CourseModel{
+				Name:      "Math",
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-10T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
+				Teacher: &models.TeacherModel{
+					ID: 1,
+				},
+			},
+			expectedError:      &models.TeacherScheduleConflictError{Courses: []*models.CourseModel{physics}},
+			mockRepoInputID:    "2",
+			mockPattern:        pattern,
+			mockTeacherCourses: []*models.CourseModel{physics},
+		},
+		{
+			name:    "capacity is more than a booked room seats",
+			inputID: "2",
+			inputCourse: &models.CourseModel{
+				Name:      "Math",
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-03T00:00:00Z",
+				Capacity:  &capacity,
+				Term: &models.TermModel{
+					ID: 1,
+				},
+				Teacher: &models.TeacherModel{
+					ID: 1,
+				},
+			},
+			expectedError: &models.RoomTooSmallError{Capacity: 30, Courses: []*models.CourseModel{{
+				Name:      "Math",
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-03T00:00:00Z",
+				Capacity:  &capacity,
+				Term:      &models.TermModel{ID: 1},
+				Teacher:   &models.TeacherModel{ID: 1},
+			}}},
+			mockRepoInputID: "2",
+			mockCourseRooms: []*repositories.CourseRoomEntity{{CourseID: 2, Room: &repositories.RoomEntity{ID: 1, Capacity: 30}}},
+		},
+		{
+			name:    "new sessions overlap another booking of the room",
+			inputID: "2",
+			inputCourse: &models.CourseModel{
+				Name:      "Math",
+				StartTime: "2020-11-02T00:00:00Z",
+				EndTime:   "2020-11-10T00:00:00Z",
+				Term: &models.TermModel{
+					ID: 1,
+				},
+				Teacher: &models.TeacherModel{
+					ID: 1,
+				},
+			},
+			expectedError:   &models.RoomConflictError{Courses: []*models.CourseModel{{ID: 4, Name: "Chemistry"}}},
+			mockRepoInputID: "2",
+			mockPattern:     pattern,
+			mockCourseRooms: []*repositories.CourseRoomEntity{{CourseID: 2, Room: &repositories.RoomEntity{ID: 1}}},
+			mockRoomBookings: []*repositories.RoomBookingEntity{
+				{RoomID: 1, CourseID: 2, CourseName: "Math", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+				{RoomID: 1, CourseID: 4, CourseName: "Chemistry", StartTime: "2020-11-09T10:00:00Z", EndTime: "2020-11-09T11:00:00Z"},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			mockRepo := new(MocCourseRepository)
-			mockRepo.On("UpdateCourse", testCase.mockRepoInputID, testCase.mockRepoInputCourse).Return(testCase.mockRepoError)
+			mockRepo.On("UpdateCourse", testCase.mockRepoInputID, testCase.mockRepoInputCourse, testCase.mockSessions, 0).Return(testCase.mockRepoError)
+			var mockPatternError error
+			if testCase.mockPattern == nil {
+				mockPatternError = models.ErrMeetingPatternNotFound
+			}
+			mockRepo.On("GetMeetingPattern", testCase.inputID).Return(testCase.mockPattern, mockPatternError)
+			mockRepo.On("GetCourses", &models.CourseFilterModel{TeacherID: "1"}).Return(testCase.mockTeacherCourses, nil)
+			mockRepo.On("GetCourseSessions", "3").Return([]*repositories.SessionEntity{
+				{CourseID: 3, Date: "2020-11-09", StartTime: "2020-11-09T10:00:00Z", EndTime: "2020-11-09T11:00:00Z"},
+			}, nil)
+
+			mockTermRepo := new(MockTermRepository)
+			mockTermRepo.On("GetTermByID", "1").Return(fall2020, nil)
+
+			mockTeacherRepo := new(MockTeacherRepository)
+			mockTeacherRepo.On("GetTeacherByID", "1").Return(&repositories.TeacherEntity{ID: 1}, nil)
+
+			mockRoomRepo := new(MockRoomRepository)
+			mockRoomRepo.On("GetCourseRooms", mock.Anything).Return(testCase.mockCourseRooms, nil)
+			mockRoomRepo.On("GetRoomBookings", 1, "2020-11-02T09:00:00Z", "2020-11-09T10:30:00Z").Return(testCase.mockRoomBookings, nil)
 
 			courseService := Course{
-				CourseRepositories: mockRepo,
+				CourseRepositories:  mockRepo,
+				TermRepositories:    mockTermRepo,
+				TeacherRepositories: mockTeacherRepo,
+				RoomRepositories:    mockRoomRepo,
 			}
 
 			err := courseService.UpdateCourse(testCase.inputID, testCase.inputCourse)
@@ -304,7 +642,401 @@ func Test_UpdateCourse(t *testing.T) {
 				require.EqualError(t, err, testCase.expectedError.Error())
 			} else {
 				require.NoError(t, err)
+				mockRepo.AssertCalled(t, "UpdateCourse", testCase.mockRepoInputID, testCase.mockRepoInputCourse, testCase.mockSessions, 0)
 			}
 		})
 	}
 }
+
+func Test_GetCourseStudents(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputID        string
+		inputFilter    *models.RosterFilterModel
+		expectedValue  []*repositories.StudentEntity
+		expectedTotal  int
+		expectedError  error
+		mockRepoResult []*repositories.StudentEntity
+		mockRepoTotal  int
+		mockRepoError  error
+	}{
+		{
+			name:           "get course students fail",
+			inputID:        "3",
+			inputFilter:    &models.RosterFilterModel{Limit: 20},
+			expectedValue:  nil,
+			expectedError:  models.ErrCourseNotFound,
+			mockRepoResult: nil,
+			mockRepoError:  models.ErrCourseNotFound,
+		},
+		{
+			name:        "get course students successfully",
+			inputID:     "1",
+			inputFilter: &models.RosterFilterModel{Limit: 20},
+			expectedValue: []*repositories.StudentEntity{
+				{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "Le"},
+			},
+			expectedTotal: 1,
+			expectedError: nil,
+			mockRepoResult: []*repositories.StudentEntity{
+				{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "Le"},
+			},
+			mockRepoTotal: 1,
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MocCourseRepository)
+			mockRepo.On("GetCourseStudents", testCase.inputID, testCase.inputFilter).Return(testCase.mockRepoResult, testCase.mockRepoTotal, testCase.mockRepoError)
+
+			courseService := Course{
+				CourseRepositories: mockRepo,
+			}
+
+			result, total, err := courseService.GetCourseStudents(testCase.inputID, testCase.inputFilter)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+				require.Equal(t, testCase.expectedTotal, total)
+			}
+		})
+	}
+}
+
+func Test_AddPrerequisite(t *testing.T) {
+	testCases := []struct {
+		name                string
+		inputID             string
+		inputPrerequisiteID string
+		expectedError       error
+		mockRepoError       error
+	}{
+		{
+			name:                "invalid course id",
+			inputID:             "math",
+			inputPrerequisiteID: "1",
+			expectedError:       models.ErrCourseNotFound,
+		},
+		{
+			name:                "course requires itself",
+			inputID:             "1",
+			inputPrerequisiteID: "1",
+			expectedError:       models.ErrPrerequisiteCycle,
+		},
+		{
+			name:                "prerequisite creates a cycle",
+			inputID:             "1",
+			inputPrerequisiteID: "3",
+			expectedError:       models.ErrPrerequisiteCycle,
+			mockRepoError:       models.ErrPrerequisiteCycle,
+		},
+		{
+			name:                "add prerequisite successfully",
+			inputID:             "3",
+			inputPrerequisiteID: "1",
+			expectedError:       nil,
+			mockRepoError:       nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MocCourseRepository)
+			mockRepo.On("AddPrerequisite", testCase.inputID, testCase.inputPrerequisiteID).Return(testCase.mockRepoError)
+
+			courseService := Course{
+				CourseRepositories: mockRepo,
+			}
+
+			err := courseService.AddPrerequisite(testCase.inputID, testCase.inputPrerequisiteID)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_BulkEnrollCourse(t *testing.T) {
+	math := &models.CourseModel{
+		ID:        1,
+		Name:      "Math",
+		StartTime: "2020-11-02T00:00:00Z",
+		EndTime:   "2020-11-03T00:00:00Z",
+	}
+	creditLimit := &models.CreditLimitExceededError{Term: "Fall 2020", CurrentCredits: 18, CourseCredits: 3, Limit: 18}
+
+	testCases := []struct {
+		name              string
+		inputID           string
+		inputBulk         *models.BulkEnrollmentModel
+		expectedValue     []*repositories.BulkEnrollmentResultEntity
+		expectedError     error
+		mockCourseResult  *models.CourseModel
+		mockCourseError   error
+		mockStudentCode   string
+		mockStudentResult *repositories.StudentEntity
+		mockStudentError  error
+		mockRepoInputIDs  []int
+		mockRepoResult    []*repositories.BulkEnrollmentResultEntity
+		mockRepoError     error
+	}{
+		{
+			name:    "course not found",
+			inputID: "2",
+			inputBulk: &models.BulkEnrollmentModel{
+				StudentIDs: []int{1},
+				Mode:       models.BulkEnrollmentAllOrNothing,
+				Options:    &models.EnrollmentOptionsModel{},
+			},
+			expectedValue:    nil,
+			expectedError:    models.ErrCourseNotFound,
+			mockCourseResult: nil,
+			mockCourseError:  sql.ErrNoRows,
+		},
+		{
+			name:    "all or nothing stops before enrolling",
+			inputID: "1",
+			inputBulk: &models.BulkEnrollmentModel{
+				StudentIDs:   []int{1},
+				StudentCodes: []string{"999999"},
+				Mode:         models.BulkEnrollmentAllOrNothing,
+				Options:      &models.EnrollmentOptionsModel{},
+			},
+			expectedValue: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 1, Error: models.ErrBulkEnrollmentRolledBack.Error(), Code: models.EnrollmentRolledBack},
+				{StudentCode: "999999", Error: models.ErrStudentNotFound.Error(), Code: models.EnrollmentStudentNotFound},
+			},
+			expectedError:    nil,
+			mockCourseResult: math,
+			mockStudentCode:  "999999",
+			mockStudentError: models.ErrStudentNotFound,
+		},
+		{
+			name:    "best effort enrolls eligible students",
+			inputID: "1",
+			inputBulk: &models.BulkEnrollmentModel{
+				StudentIDs:   []int{1},
+				StudentCodes: []string{"999999"},
+				Mode:         models.BulkEnrollmentBestEffort,
+				Options:      &models.EnrollmentOptionsModel{},
+			},
+			expectedValue: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 1, Success: true, Enrollment: &repositories.EnrollmentEntity{ID: 3, StudentID: 1, CourseID: 1, Status: "active"}},
+				{StudentCode: "999999", Error: models.ErrStudentNotFound.Error(), Code: models.EnrollmentStudentNotFound},
+			},
+			expectedError:    nil,
+			mockCourseResult: math,
+			mockStudentCode:  "999999",
+			mockStudentError: models.ErrStudentNotFound,
+			mockRepoInputIDs: []int{1},
+			mockRepoResult: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 1, Success: true, Enrollment: &repositories.EnrollmentEntity{ID: 3, StudentID: 1, CourseID: 1, Status: "active"}},
+			},
+		},
+		{
+			name:    "enrollment failure keeps its code and details",
+			inputID: "1",
+			inputBulk: &models.BulkEnrollmentModel{
+				StudentIDs: []int{1},
+				Mode:       models.BulkEnrollmentBestEffort,
+				Options:    &models.EnrollmentOptionsModel{},
+			},
+			expectedValue: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 1, Error: creditLimit.Error(), Code: models.EnrollmentCreditLimitExceeded, Details: creditLimit.Details()},
+			},
+			expectedError:    nil,
+			mockCourseResult: math,
+			mockRepoInputIDs: []int{1},
+			mockRepoResult: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 1, Error: creditLimit.Error(), Code: models.EnrollmentCreditLimitExceeded, Details: creditLimit.Details()},
+			},
+		},
+		{
+			name:    "bulk enroll course successfully",
+			inputID: "1",
+			inputBulk: &models.BulkEnrollmentModel{
+				StudentIDs:   []int{1},
+				StudentCodes: []string{"234567"},
+				Mode:         models.BulkEnrollmentAllOrNothing,
+				Options:      &models.EnrollmentOptionsModel{},
+			},
+			expectedValue: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 1, Success: true, Enrollment: &repositories.EnrollmentEntity{ID: 3, StudentID: 1, CourseID: 1, Status: "active"}},
+				{StudentID: 2, StudentCode: "234567", Success: true, Enrollment: &repositories.EnrollmentEntity{ID: 4, StudentID: 2, CourseID: 1, Status: "active"}},
+			},
+			expectedError:     nil,
+			mockCourseResult:  math,
+			mockStudentCode:   "234567",
+			mockStudentResult: &repositories.StudentEntity{ID: 2, StudentID: "234567"},
+			mockRepoInputIDs:  []int{1, 2},
+			mockRepoResult: []*repositories.BulkEnrollmentResultEntity{
+				{StudentID: 1, Success: true, Enrollment: &repositories.EnrollmentEntity{ID: 3, StudentID: 1, CourseID: 1, Status: "active"}},
+				{StudentID: 2, Success: true, Enrollment: &repositories.EnrollmentEntity{ID: 4, StudentID: 2, CourseID: 1, Status: "active"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MocCourseRepository)
+			mockRepo.On("GetCourseByID", testCase.inputID).Return(testCase.mockCourseResult, testCase.mockCourseError)
+			mockRepo.On("GetPrerequisites", testCase.inputID).Return([]*models.CourseModel{}, nil)
+			mockRepo.On("GetCourseSessions", testCase.inputID).Return([]*repositories.SessionEntity{}, nil)
+			mockRepo.On("BulkEnrollCourse", testCase.inputID, testCase.mockRepoInputIDs, testCase.inputBulk.Mode, testCase.inputBulk.Options, 0).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			mockStudentRepo := new(MockStudentRepository)
+			mockStudentRepo.On("GetStudentByCode", testCase.mockStudentCode).Return(testCase.mockStudentResult, testCase.mockStudentError)
+			mockStudentRepo.On("GetStudentCourses", mock.Anything, &models.StudentCourseFilterModel{Status: "active"}).Return([]*models.CourseModel{}, nil)
+
+			courseService := Course{
+				CourseRepositories:  mockRepo,
+				StudentRepositories: mockStudentRepo,
+			}
+
+			result, err := courseService.BulkEnrollCourse(testCase.inputID, testCase.inputBulk)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_SetCourseTeacher(t *testing.T) {
+	archivedAt := "2020-12-31T00:00:00Z"
+	math := &models.CourseModel{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-20T00:00:00Z", Teacher: &models.TeacherModel{ID: 1}}
+	physics := &models.CourseModel{ID: 2, Name: "Physics", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-20T00:00:00Z", Teacher: &models.TeacherModel{ID: 2}}
+	var noCourse *models.CourseModel
+	var noTeacher *repositories.TeacherEntity
+	var noPattern *repositories.MeetingPatternEntity
+
+	testCases := []struct {
+		name                     string
+		inputTeacherID           string
+		expectedError            error
+		mockCourseResult         *models.CourseModel
+		mockCourseError          error
+		mockTeacherResult        *repositories.TeacherEntity
+		mockTeacherError         error
+		mockTeacherCourses       []*models.CourseModel
+		expectedSetCourseTeacher bool
+	}{
+		{
+			name:             "course not found",
+			inputTeacherID:   "2",
+			expectedError:    models.ErrCourseNotFound,
+			mockCourseResult: noCourse,
+			mockCourseError:  sql.ErrNoRows,
+		},
+		{
+			name:             "primary teacher",
+			inputTeacherID:   "1",
+			expectedError:    models.ErrPrimaryTeacher,
+			mockCourseResult: math,
+		},
+		{
+			name:              "teacher not found",
+			inputTeacherID:    "9",
+			expectedError:     models.ErrTeacherNotFound,
+			mockCourseResult:  math,
+			mockTeacherResult: noTeacher,
+			mockTeacherError:  sql.ErrNoRows,
+		},
+		{
+			name:              "teacher is archived",
+			inputTeacherID:    "2",
+			expectedError:     models.ErrTeacherArchived,
+			mockCourseResult:  math,
+			mockTeacherResult: &repositories.TeacherEntity{ID: 2, ArchivedAt: &archivedAt},
+		},
+		{
+			name:               "course overlaps a course of the teacher",
+			inputTeacherID:     "2",
+			expectedError:      &models.TeacherScheduleConflictError{Courses: []*models.CourseModel{physics}},
+			mockCourseResult:   math,
+			mockTeacherResult:  &repositories.TeacherEntity{ID: 2},
+			mockTeacherCourses: []*models.CourseModel{physics},
+		},
+		{
+			name:                     "set course teacher successfully",
+			inputTeacherID:           "2",
+			mockCourseResult:         math,
+			mockTeacherResult:        &repositories.TeacherEntity{ID: 2},
+			expectedSetCourseTeacher: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MocCourseRepository)
+			mockRepo.On("GetCourseByID", "1").Return(testCase.mockCourseResult, testCase.mockCourseError)
+			mockRepo.On("SetCourseTeacher", 1, 2, models.CourseTeacherRoleAssistant).Return(nil)
+			mockRepo.On("GetCourses", &models.CourseFilterModel{TeacherID: testCase.inputTeacherID}).Return(testCase.mockTeacherCourses, nil)
+			mockRepo.On("GetMeetingPattern", "1").Return(noPattern, models.ErrMeetingPatternNotFound)
+			mockRepo.On("GetCourseSessions", "1").Return([]*repositories.SessionEntity{
+				{Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+			}, nil)
+			mockRepo.On("GetCourseSessions", "2").Return([]*repositories.SessionEntity{
+				{Date: "2020-11-02", StartTime: "2020-11-02T10:00:00Z", EndTime: "2020-11-02T11:00:00Z"},
+			}, nil)
+			mockTeacherRepo := new(MockTeacherRepository)
+			mockTeacherRepo.On("GetTeacherByID", testCase.inputTeacherID).Return(testCase.mockTeacherResult, testCase.mockTeacherError)
+
+			courseService := Course{
+				CourseRepositories:  mockRepo,
+				TeacherRepositories: mockTeacherRepo,
+			}
+
+			err := courseService.SetCourseTeacher("1", testCase.inputTeacherID, models.CourseTeacherRoleAssistant)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if testCase.expectedSetCourseTeacher {
+				mockRepo.AssertCalled(t, "SetCourseTeacher", 1, 2, models.CourseTeacherRoleAssistant)
+			} else {
+				mockRepo.AssertNotCalled(t, "SetCourseTeacher", 1, 2, models.CourseTeacherRoleAssistant)
+			}
+		})
+	}
+}
+
+func Test_GetCoursesWithTeachers(t *testing.T) {
+	lead := &models.CourseTeacherModel{Teacher: &models.TeacherModel{ID: 1}, Role: models.CourseTeacherRoleLead}
+	grader := &models.CourseTeacherModel{Teacher: &models.TeacherModel{ID: 2}, Role: models.CourseTeacherRoleGrader}
+
+	mockRepo := new(MocCourseRepository)
+	mockRepo.On("GetCourses", &models.CourseFilterModel{TermID: "1"}).Return([]*models.CourseModel{
+		{ID: 1, Name: "Math"},
+		{ID: 2, Name: "Physics"},
+	}, nil)
+	mockRepo.On("GetCourseTeachers", []int{1, 2}).Return(map[int][]*models.CourseTeacherModel{
+		1: {lead, grader},
+		2: {lead},
+	}, nil)
+
+	courseService := Course{
+		CourseRepositories: mockRepo,
+	}
+
+	result, err := courseService.GetCoursesWithTeachers(&models.CourseFilterModel{TermID: "1"})
+
+	require.NoError(t, err)
+	require.Equal(t, []*models.CourseModel{
+		{ID: 1, Name: "Math", Teachers: []*models.CourseTeacherModel{lead, grader}},
+		{ID: 2, Name: "Physics", Teachers: []*models.CourseTeacherModel{lead}},
+	}, result)
+}
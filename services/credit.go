@@ -0,0 +1,31 @@
+package services
+
+import (
+	"student_rest/models"
+)
+
+// checkCreditLoad returns a CreditLimitExceededError when the course takes the student over limit credits in its term.
+// enrolledCourses are the courses the student is active in, only those in the same term count towards the load.
+// A limit of 0 turns the check off.
+func checkCreditLoad(course *models.CourseModel, enrolledCourses []*models.CourseModel, limit int) error {
+	if limit == 0 || course.Credits == 0 {
+		return nil
+	}
+
+	current := 0
+	for _, enrolled := range enrolledCourses {
+		if enrolled.Term.ID == course.Term.ID {
+			current += enrolled.Credits
+		}
+	}
+
+	if current+course.Credits > limit {
+		return &models.CreditLimitExceededError{
+			Term:           course.Term.Name,
+			CurrentCredits: current,
+			CourseCredits:  course.Credits,
+			Limit:          limit,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"testing"
+)
+
+func Test_CheckCreditLoad(t *testing.T) {
+	fall := &models.TermModel{ID: 1, Name: "Fall 2020"}
+	spring := &models.TermModel{ID: 2, Name: "Spring 2021"}
+	course := &models.CourseModel{ID: 1, Name: "Biology", Credits: 4, Term: fall}
+	enrolled := []*models.CourseModel{
+		{ID: 2, Name: "History", Credits: 8, Term: fall},
+		{ID: 3, Name: "Math", Credits: 6, Term: fall},
+		{ID: 4, Name: "Art", Credits: 10, Term: spring},
+	}
+
+	testCases := []struct {
+		name          string
+		inputCourse   *models.CourseModel
+		inputEnrolled []*models.CourseModel
+		inputLimit    int
+		expectedError error
+	}{
+		{
+			name:          "limit turned off",
+			inputCourse:   course,
+			inputEnrolled: enrolled,
+			inputLimit:    0,
+			expectedError: nil,
+		},
+		{
+			name:          "course without credits",
+			inputCourse:   &models.CourseModel{ID: 1, Term: fall},
+			inputEnrolled: enrolled,
+			inputLimit:    14,
+			expectedError: nil,
+		},
+		{
+			name:          "load reaches the limit",
+			inputCourse:   course,
+			inputEnrolled: enrolled,
+			inputLimit:    18,
+			expectedError: nil,
+		},
+		{
+			name:          "load over the limit",
+			inputCourse:   course,
+			inputEnrolled: enrolled,
+			inputLimit:    17,
+			expectedError: &models.CreditLimitExceededError{
+				Term:           "Fall 2020",
+				CurrentCredits: 14,
+				CourseCredits:  4,
+				Limit:          17,
+			},
+		},
+		{
+			name:          "course alone over the limit",
+			inputCourse:   course,
+			inputEnrolled: nil,
+			inputLimit:    3,
+			expectedError: &models.CreditLimitExceededError{
+				Term:           "Fall 2020",
+				CurrentCredits: 0,
+				CourseCredits:  4,
+				Limit:          3,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkCreditLoad(testCase.inputCourse, testCase.inputEnrolled, testCase.inputLimit)
+
+			if testCase.expectedError != nil {
+				require.Equal(t, testCase.expectedError, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+)
+
+type Department struct {
+	repositories.DepartmentRepositories
+}
+
+type DepartmentServices interface {
+	CreateDepartment(department *models.DepartmentModel) (*repositories.DepartmentEntity, error)
+	GetDepartments() ([]*repositories.DepartmentEntity, error)
+	GetDepartmentByID(id string) (*repositories.DepartmentEntity, error)
+	UpdateDepartment(id string, department *models.DepartmentModel) error
+	DeleteDepartment(id string) error
+}
+
+func (_self Department) CreateDepartment(department *models.DepartmentModel) (*repositories.DepartmentEntity, error) {
+	return _self.DepartmentRepositories.CreateDepartment(transformDepartmentModelToDepartmentEntity(department))
+}
+
+func transformDepartmentModelToDepartmentEntity(model *models.DepartmentModel) *repositories.DepartmentEntity {
+	return &repositories.DepartmentEntity{
+		Code: model.Code,
+		Name: model.Name,
+	}
+}
+
+func (_self Department) GetDepartments() ([]*repositories.DepartmentEntity, error) {
+	return _self.DepartmentRepositories.GetDepartments()
+}
+
+func (_self Department) GetDepartmentByID(id string) (*repositories.DepartmentEntity, error) {
+	return _self.DepartmentRepositories.GetDepartmentByID(id)
+}
+
+func (_self Department) UpdateDepartment(id string, department *models.DepartmentModel) error {
+	return _self.DepartmentRepositories.UpdateDepartment(id, transformDepartmentModelToDepartmentEntity(department))
+}
+
+func (_self Department) DeleteDepartment(id string) error {
+	return _self.DepartmentRepositories.DeleteDepartment(id)
+}
+
+// checkDepartment returns ErrDepartmentNotFound when a teacher or course refers to a department that doesn't exist,
+// they don't have to belong to a department
+func checkDepartment(departmentRepositories repositories.DepartmentRepositories, departmentID *int) error {
+	if departmentID == nil {
+		return nil
+	}
+	_, err := departmentRepositories.GetDepartmentByID(strconv.Itoa(*departmentID))
+	return err
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"github.com/stretchr/testify/mock"
+	"student_rest/repositories"
+)
+
+type MockDepartmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockDepartmentRepository) CreateDepartment(department *repositories.DepartmentEntity) (*repositories.DepartmentEntity, error) {
+	returnArgs := m.Called(department)
+	return returnArgs.Get(0).(*repositories.DepartmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockDepartmentRepository) GetDepartments() ([]*repositories.DepartmentEntity, error) {
+	returnArgs := m.Called()
+	return returnArgs.Get(0).([]*repositories.DepartmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockDepartmentRepository) GetDepartmentByID(id string) (*repositories.DepartmentEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.DepartmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockDepartmentRepository) UpdateDepartment(id string, department *repositories.DepartmentEntity) error {
+	returnArgs := m.Called(id, department)
+	return returnArgs.Error(0)
+}
+
+func (m *MockDepartmentRepository) DeleteDepartment(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+	"time"
+)
+
+type Enrollment struct {
+	repositories.EnrollmentRepositories
+	StudentRepositories repositories.StudentRepositories
+	CourseRepositories  repositories.CourseRepositories
+	MaxCreditLoad       int
+}
+
+type EnrollmentServices interface {
+	GetEnrollmentByID(id string) (*repositories.EnrollmentEntity, error)
+	UpdateEnrollmentStatus(id string, change *models.EnrollmentStatusChangeModel) (*repositories.EnrollmentEntity, error)
+}
+
+// GetEnrollmentByID returns the enrollment with its status history
+func (_self Enrollment) GetEnrollmentByID(id string) (*repositories.EnrollmentEntity, error) {
+	enrollment, err := _self.EnrollmentRepositories.GetEnrollmentByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment.History, err = _self.EnrollmentRepositories.GetEnrollmentHistory(id)
+	if err != nil {
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+// UpdateEnrollmentStatus moves the enrollment to the new status when the transition is allowed,
+// an enrollment becoming active has to pass the same rules as a new enrollment
+func (_self Enrollment) UpdateEnrollmentStatus(id string, change *models.EnrollmentStatusChangeModel) (*repositories.EnrollmentEntity, error) {
+	enrollment, err := _self.EnrollmentRepositories.GetEnrollmentByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkStatusTransition(enrollment.Status, change.Status); err != nil {
+		return nil, err
+	}
+
+	if change.Status == models.EnrollmentStatusActive {
+		course, err := getCourse(_self.CourseRepositories, strconv.Itoa(enrollment.CourseID))
+		if err != nil {
+			return nil, err
+		}
+
+		rules := enrollmentRules{
+			StudentRepositories: _self.StudentRepositories,
+			CourseRepositories:  _self.CourseRepositories,
+		}
+		if err = rules.check(strconv.Itoa(enrollment.StudentID), course, &models.EnrollmentOptionsModel{}); err != nil {
+			return nil, err
+		}
+	}
+
+	enrollment, err = _self.EnrollmentRepositories.UpdateEnrollmentStatus(id, enrollment.Status, change, _self.MaxCreditLoad)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment.History, err = _self.EnrollmentRepositories.GetEnrollmentHistory(id)
+	if err != nil {
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+// enrollmentRules holds the checks every enrollment path runs before the enrollment is written,
+// the credit limit is checked by the repositories while they hold the lock on the student
+type enrollmentRules struct {
+	StudentRepositories repositories.StudentRepositories
+	CourseRepositories  repositories.CourseRepositories
+}
+
+func (_self enrollmentRules) check(studentID string, course *models.CourseModel, options *models.EnrollmentOptionsModel) error {
+	if err := _self.checkRegistrationWindow(studentID, course); err != nil {
+		return err
+	}
+
+	if err := _self.checkPrerequisites(studentID, course); err != nil {
+		return err
+	}
+
+	if !options.OverrideScheduleConflict {
+		if err := _self.checkScheduleConflicts(studentID, course); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRegistrationWindow refuses enrollments outside the registration window, unless the student was given an override
+func (_self enrollmentRules) checkRegistrationWindow(studentID string, course *models.CourseModel) error {
+	windowErr := checkRegistrationWindow(course, time.Now())
+	if windowErr == nil {
+		return nil
+	}
+
+	overridden, err := _self.CourseRepositories.HasRegistrationOverride(strconv.Itoa(course.ID), studentID)
+	if err != nil {
+		return err
+	}
+	if overridden {
+		return nil
+	}
+	return windowErr
+}
+
+// checkPrerequisites returns a MissingPrerequisitesError when the student hasn't completed every prerequisite of the course
+func (_self enrollmentRules) checkPrerequisites(studentID string, course *models.CourseModel) error {
+	prerequisites, err := _self.CourseRepositories.GetPrerequisites(strconv.Itoa(course.ID))
+	if err != nil {
+		return err
+	}
+	if len(prerequisites) == 0 {
+		return nil
+	}
+
+	completedCourses, err := _self.StudentRepositories.GetStudentCourses(studentID, &models.StudentCourseFilterModel{
+		Status: models.EnrollmentStatusCompleted,
+	})
+	if err != nil {
+		return err
+	}
+
+	missing := findMissingPrerequisites(prerequisites, completedCourses)
+	if len(missing) > 0 {
+		return &models.MissingPrerequisitesError{Courses: missing}
+	}
+	return nil
+}
+
+// checkScheduleConflicts returns a ScheduleConflictError when the sessions of the course overlap the sessions of
+// one the student is active in
+func (_self enrollmentRules) checkScheduleConflicts(studentID string, course *models.CourseModel) error {
+	enrolledCourses, err := _self.StudentRepositories.GetStudentCourses(studentID, &models.StudentCourseFilterModel{
+		Status: models.EnrollmentStatusActive,
+	})
+	if err != nil {
+		return err
+	}
+
+	sessions, err := _self.CourseRepositories.GetCourseSessions(strconv.Itoa(course.ID))
+	if err != nil {
+		return err
+	}
+	conflicts, err := findSessionConflicts(_self.CourseRepositories, course, sessions, enrolledCourses)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return &models.ScheduleConflictError{Courses: conflicts}
+	}
+	return nil
+}
+
+// getCourse returns the course, or ErrCourseNotFound when it doesn't exist
+func getCourse(courseRepositories repositories.CourseRepositories, courseID string) (*models.CourseModel, error) {
+	course, err := courseRepositories.GetCourseByID(courseID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrCourseNotFound
+	}
+	return course, err
+}
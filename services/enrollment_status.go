@@ -0,0 +1,28 @@
+package services
+
+import "student_rest/models"
+
+// enrollmentTransitions lists the statuses an enrollment can move to from each status.
+// Withdrawn, completed and failed are final.
+var enrollmentTransitions = map[string][]string{
+	models.EnrollmentStatusWaitlisted: {models.EnrollmentStatusActive, models.EnrollmentStatusWithdrawn},
+	models.EnrollmentStatusActive:     {models.EnrollmentStatusCompleted, models.EnrollmentStatusFailed, models.EnrollmentStatusWithdrawn},
+	models.EnrollmentStatusWithdrawn:  {},
+	models.EnrollmentStatusCompleted:  {},
+	models.EnrollmentStatusFailed:     {},
+}
+
+// checkStatusTransition returns an InvalidStatusTransitionError when an enrollment can't move from one status to the other
+func checkStatusTransition(from string, to string) error {
+	allowed := enrollmentTransitions[from]
+	for _, status := range allowed {
+		if status == to {
+			return nil
+		}
+	}
+	return &models.InvalidStatusTransitionError{
+		From:    from,
+		To:      to,
+		Allowed: allowed,
+	}
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockEnrollmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockEnrollmentRepository) GetEnrollmentByID(id string) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockEnrollmentRepository) GetEnrollmentHistory(id string) ([]*repositories.EnrollmentStatusChangeEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*repositories.EnrollmentStatusChangeEntity), returnArgs.Error(1)
+}
+
+func (m *MockEnrollmentRepository) UpdateEnrollmentStatus(id string, from string, change *models.EnrollmentStatusChangeModel, maxCreditLoad int) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(id, from, change, maxCreditLoad)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func Test_UpdateEnrollmentStatus(t *testing.T) {
+	active := "active"
+	history := []*repositories.EnrollmentStatusChangeEntity{
+		{ID: 1, ToStatus: "active", ChangedAt: "2020-11-02T00:00:00Z"},
+		{ID: 2, FromStatus: &active, ToStatus: "completed", ChangedAt: "2020-11-03T00:00:00Z"},
+	}
+
+	testCases := []struct {
+		name             string
+		inputID          string
+		inputChange      *models.EnrollmentStatusChangeModel
+		expectedValue    *repositories.EnrollmentEntity
+		expectedError    error
+		mockCurrent      *repositories.EnrollmentEntity
+		mockCurrentError error
+		mockRepoResult   *repositories.EnrollmentEntity
+		mockRepoError    error
+		mockCourse       *models.CourseModel
+		mockPrerequisite []*models.CourseModel
+	}{
+		{
+			name:             "enrollment not found",
+			inputID:          "3",
+			inputChange:      &models.EnrollmentStatusChangeModel{Status: "completed"},
+			expectedValue:    nil,
+			expectedError:    models.ErrEnrollmentNotFound,
+			mockCurrent:      nil,
+			mockCurrentError: models.ErrEnrollmentNotFound,
+		},
+		{
+			name:          "completed enrollment can't be reactivated",
+			inputID:       "1",
+			inputChange:   &models.EnrollmentStatusChangeModel{Status: "active"},
+			expectedValue: nil,
+			expectedError: &models.InvalidStatusTransitionError{From: "completed", To: "active"},
+			mockCurrent:   &repositories.EnrollmentEntity{ID: 1, Status: "completed"},
+		},
+		{
+			name:          "waitlisted enrollment can't be completed",
+			inputID:       "1",
+			inputChange:   &models.EnrollmentStatusChangeModel{Status: "completed"},
+			expectedValue: nil,
+			expectedError: &models.InvalidStatusTransitionError{
+				From:    "waitlisted",
+				To:      "completed",
+				Allowed: []string{"active", "withdrawn"},
+			},
+			mockCurrent: &repositories.EnrollmentEntity{ID: 1, Status: "waitlisted"},
+		},
+		{
+			name:          "waitlisted enrollment missing a prerequisite can't be activated",
+			inputID:       "1",
+			inputChange:   &models.EnrollmentStatusChangeModel{Status: "active"},
+			expectedValue: nil,
+			expectedError: &models.MissingPrerequisitesError{Courses: []*models.CourseModel{{ID: 1, Name: "Math"}}},
+			mockCurrent:   &repositories.EnrollmentEntity{ID: 1, StudentID: 1, CourseID: 2, Status: "waitlisted"},
+			mockCourse:    &models.CourseModel{ID: 2, Name: "Physics", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"},
+			mockPrerequisite: []*models.CourseModel{
+				{ID: 1, Name: "Math"},
+			},
+		},
+		{
+			name:        "activate waitlisted enrollment successfully",
+			inputID:     "1",
+			inputChange: &models.EnrollmentStatusChangeModel{Status: "active"},
+			expectedValue: &repositories.EnrollmentEntity{
+				ID:      1,
+				Status:  "active",
+				History: history,
+			},
+			expectedError:    nil,
+			mockCurrent:      &repositories.EnrollmentEntity{ID: 1, StudentID: 1, CourseID: 2, Status: "waitlisted"},
+			mockRepoResult:   &repositories.EnrollmentEntity{ID: 1, Status: "active"},
+			mockCourse:       &models.CourseModel{ID: 2, Name: "Physics", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"},
+			mockPrerequisite: []*models.CourseModel{},
+		},
+		{
+			name:          "status changed by another request",
+			inputID:       "1",
+			inputChange:   &models.EnrollmentStatusChangeModel{Status: "completed"},
+			expectedValue: nil,
+			expectedError: models.ErrEnrollmentStatusChanged,
+			mockCurrent:   &repositories.EnrollmentEntity{ID: 1, Status: "active"},
+			mockRepoError: models.ErrEnrollmentStatusChanged,
+		},
+		{
+			name:        "update enrollment status successfully",
+			inputID:     "1",
+			inputChange: &models.EnrollmentStatusChangeModel{Status: "completed"},
+			expectedValue: &repositories.EnrollmentEntity{
+				ID:      1,
+				Status:  "completed",
+				History: history,
+			},
+			expectedError:  nil,
+			mockCurrent:    &repositories.EnrollmentEntity{ID: 1, Status: "active"},
+			mockRepoResult: &repositories.EnrollmentEntity{ID: 1, Status: "completed"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockEnrollmentRepository)
+			mockRepo.On("GetEnrollmentByID", testCase.inputID).Return(testCase.mockCurrent, testCase.mockCurrentError)
+			if testCase.mockCurrent != nil {
+				mockRepo.On("UpdateEnrollmentStatus", testCase.inputID, testCase.mockCurrent.Status, testCase.inputChange, 0).Return(testCase.mockRepoResult, testCase.mockRepoError)
+			}
+			mockRepo.On("GetEnrollmentHistory", testCase.inputID).Return(history, nil)
+
+			mockCourseRepo := new(MocCourseRepository)
+			mockCourseRepo.On("GetCourseByID", "2").Return(testCase.mockCourse, nil)
+			mockCourseRepo.On("GetCourseSessions", "2").Return([]*repositories.SessionEntity{}, nil)
+			mockCourseRepo.On("GetPrerequisites", "2").Return(testCase.mockPrerequisite, nil)
+			mockStudentRepo := new(MockStudentRepository)
+			mockStudentRepo.On("GetStudentCourses", "1", mock.Anything).Return([]*models.CourseModel{}, nil)
+
+			enrollmentService := Enrollment{
+				EnrollmentRepositories: mockRepo,
+				StudentRepositories:    mockStudentRepo,
+				CourseRepositories:     mockCourseRepo,
+			}
+
+			result, err := enrollmentService.UpdateEnrollmentStatus(testCase.inputID, testCase.inputChange)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
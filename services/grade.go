@@ -0,0 +1,83 @@
+package services
+
+import (
+	"math"
+	"student_rest/models"
+	"student_rest/repositories"
+)
+
+// recordGrade checks the grade against the scale before storing it, it is shared by the student and course routes
+func recordGrade(studentRepositories repositories.StudentRepositories, scale *models.GradeScaleModel,
+	studentID string, courseID string, grade *models.RecordGradeModel) (*repositories.EnrollmentEntity, error) {
+	if grade.Letter != nil {
+		if _, ok := scale.Letters[*grade.Letter]; !ok {
+			return nil, models.ErrInvalidGrade
+		}
+	}
+	return studentRepositories.RecordGrade(studentID, courseID, grade)
+}
+
+// gradePoints returns the grade points of a letter or numeric grade on the scale
+func gradePoints(scale *models.GradeScaleModel, grade *models.GradeModel) (float64, error) {
+	if grade.Letter != nil {
+		points, ok := scale.Letters[*grade.Letter]
+		if !ok {
+			return 0, models.ErrInvalidGrade
+		}
+		return points, nil
+	}
+	if grade.Score != nil {
+		for _, threshold := range scale.Thresholds {
+			if *grade.Score >= threshold.MinScore {
+				return threshold.Points, nil
+			}
+		}
+	}
+	return 0, models.ErrInvalidGrade
+}
+
+// calculateGPA averages the grade points weighted by course credits per term and over all grades,
+// a course without credits doesn't count. The grades must be ordered by term so the terms come out in order.
+func calculateGPA(scale *models.GradeScaleModel, grades []*models.GradeModel) (*models.GPAModel, error) {
+	gpa := &models.GPAModel{
+		Terms: []*models.TermGPAModel{},
+	}
+
+	total, credits := 0.0, 0
+	termTotal, termCredits := 0.0, 0
+	var term *models.TermGPAModel
+	for _, grade := range grades {
+		points, err := gradePoints(scale, grade)
+		if err != nil {
+			return nil, err
+		}
+		if grade.Credits == 0 {
+			continue
+		}
+
+		if term == nil || term.Term != grade.Term {
+			term = &models.TermGPAModel{Term: grade.Term}
+			termTotal, termCredits = 0, 0
+			gpa.Terms = append(gpa.Terms, term)
+		}
+
+		weighted := points * float64(grade.Credits)
+		total += weighted
+		credits += grade.Credits
+		termTotal += weighted
+		termCredits += grade.Credits
+		gpa.Courses++
+		term.Courses++
+		term.GPA = roundGPA(termTotal / float64(termCredits))
+	}
+
+	if gpa.Courses > 0 {
+		cumulative := roundGPA(total / float64(credits))
+		gpa.Cumulative = &cumulative
+	}
+	return gpa, nil
+}
+
+func roundGPA(gpa float64) float64 {
+	return math.Round(gpa*100) / 100
+}
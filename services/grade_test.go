@@ -0,0 +1,76 @@
+package services
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"testing"
+)
+
+func Test_CalculateGPA(t *testing.T) {
+	a := "A"
+	bPlus := "B+"
+	pass := "P"
+	score := 85.0
+	cumulative := 3.54
+
+	testCases := []struct {
+		name          string
+		inputGrades   []*models.GradeModel
+		expectedValue *models.GPAModel
+		expectedError error
+	}{
+		{
+			name:        "no grades",
+			inputGrades: []*models.GradeModel{},
+			expectedValue: &models.GPAModel{
+				Terms: []*models.TermGPAModel{},
+			},
+		},
+		{
+			name: "letter not on scale",
+			inputGrades: []*models.GradeModel{
+				{CourseID: 1, Credits: 3, Term: "Spring 2020", StartTime: "2020-02-01T00:00:00Z", Letter: &pass},
+			},
+			expectedError: models.ErrInvalidGrade,
+		},
+		{
+			name: "courses without credits don't count",
+			inputGrades: []*models.GradeModel{
+				{CourseID: 1, Term: "Spring 2020", StartTime: "2020-02-01T00:00:00Z", Letter: &a},
+			},
+			expectedValue: &models.GPAModel{
+				Terms: []*models.TermGPAModel{},
+			},
+		},
+		{
+			name: "term and cumulative gpa weighted by credits",
+			inputGrades: []*models.GradeModel{
+				{CourseID: 1, Credits: 4, Term: "Spring 2020", StartTime: "2020-02-01T00:00:00Z", Letter: &a},
+				{CourseID: 2, Credits: 3, Term: "Spring 2020", StartTime: "2020-03-01T00:00:00Z", Letter: &bPlus},
+				{CourseID: 4, Credits: 0, Term: "Spring 2020", StartTime: "2020-04-01T00:00:00Z", Score: &score},
+				{CourseID: 3, Credits: 2, Term: "Fall 2020", StartTime: "2020-09-01T00:00:00Z", Score: &score},
+			},
+			expectedValue: &models.GPAModel{
+				Cumulative: &cumulative,
+				Courses:    3,
+				Terms: []*models.TermGPAModel{
+					{Term: "Spring 2020", GPA: 3.7, Courses: 2},
+					{Term: "Fall 2020", GPA: 3.0, Courses: 1},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := calculateGPA(models.DefaultGradeScale, testCase.inputGrades)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package services
+
+import "student_rest/models"
+
+// findMissingPrerequisites returns the prerequisites that aren't among the completed courses
+func findMissingPrerequisites(prerequisites []*models.CourseModel, completed []*models.CourseModel) []*models.CourseModel {
+	completedIDs := map[int]bool{}
+	for _, course := range completed {
+		completedIDs[course.ID] = true
+	}
+
+	missing := []*models.CourseModel{}
+	for _, prerequisite := range prerequisites {
+		if !completedIDs[prerequisite.ID] {
+			missing = append(missing, prerequisite)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"testing"
+)
+
+func Test_FindMissingPrerequisites(t *testing.T) {
+	math := &models.CourseModel{ID: 1, Name: "Math"}
+	physics := &models.CourseModel{ID: 2, Name: "Physics"}
+
+	testCases := []struct {
+		name               string
+		inputPrerequisites []*models.CourseModel
+		inputCompleted     []*models.CourseModel
+		expectedValue      []*models.CourseModel
+	}{
+		{
+			name:               "all prerequisites completed",
+			inputPrerequisites: []*models.CourseModel{math},
+			inputCompleted:     []*models.CourseModel{math, physics},
+			expectedValue:      []*models.CourseModel{},
+		},
+		{
+			name:               "missing prerequisites",
+			inputPrerequisites: []*models.CourseModel{math, physics},
+			inputCompleted:     []*models.CourseModel{physics},
+			expectedValue:      []*models.CourseModel{math},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := findMissingPrerequisites(testCase.inputPrerequisites, testCase.inputCompleted)
+			require.Equal(t, testCase.expectedValue, result)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+)
+
+type Program struct {
+	repositories.ProgramRepositories
+	DepartmentRepositories repositories.DepartmentRepositories
+	GradeScale             *models.GradeScaleModel
+}
+
+type ProgramServices interface {
+	CreateProgram(program *models.ProgramModel) (*repositories.ProgramEntity, error)
+	GetPrograms(filter *models.ProgramFilterModel) ([]*repositories.ProgramEntity, error)
+	GetProgramByID(id string) (*repositories.ProgramEntity, error)
+	UpdateProgram(id string, program *models.ProgramModel) error
+	DeleteProgram(id string) error
+	GetProgramRequirements(id string) ([]*repositories.ProgramRequirementEntity, error)
+	CreateProgramRequirement(id string, requirement *models.ProgramRequirementModel) (*repositories.ProgramRequirementEntity, error)
+	UpdateProgramRequirement(id string, requirementID string, requirement *models.ProgramRequirementModel) error
+	DeleteProgramRequirement(id string, requirementID string) error
+}
+
+func (_self Program) CreateProgram(program *models.ProgramModel) (*repositories.ProgramEntity, error) {
+	if err := checkDepartment(_self.DepartmentRepositories, &program.DepartmentID); err != nil {
+		return nil, err
+	}
+	return _self.ProgramRepositories.CreateProgram(transformProgramModelToProgramEntity(program))
+}
+
+func transformProgramModelToProgramEntity(model *models.ProgramModel) *repositories.ProgramEntity {
+	return &repositories.ProgramEntity{
+		DepartmentID: model.DepartmentID,
+		Code:         model.Code,
+		Name:         model.Name,
+		Degree:       model.Degree,
+	}
+}
+
+func (_self Program) GetPrograms(filter *models.ProgramFilterModel) ([]*repositories.ProgramEntity, error) {
+	return _self.ProgramRepositories.GetPrograms(filter)
+}
+
+func (_self Program) GetProgramByID(id string) (*repositories.ProgramEntity, error) {
+	return _self.ProgramRepositories.GetProgramByID(id)
+}
+
+func (_self Program) UpdateProgram(id string, program *models.ProgramModel) error {
+	if err := checkDepartment(_self.DepartmentRepositories, &program.DepartmentID); err != nil {
+		return err
+	}
+	return _self.ProgramRepositories.UpdateProgram(id, transformProgramModelToProgramEntity(program))
+}
+
+func (_self Program) DeleteProgram(id string) error {
+	return _self.ProgramRepositories.DeleteProgram(id)
+}
+
+func (_self Program) GetProgramRequirements(id string) ([]*repositories.ProgramRequirementEntity, error) {
+	return _self.ProgramRepositories.GetProgramRequirements(id)
+}
+
+func (_self Program) CreateProgramRequirement(id string, requirement *models.ProgramRequirementModel) (*repositories.ProgramRequirementEntity, error) {
+	if err := checkMinGrade(_self.GradeScale, requirement.MinGrade); err != nil {
+		return nil, err
+	}
+	return _self.ProgramRepositories.CreateProgramRequirement(id, transformProgramRequirementModelToProgramRequirementEntity(requirement))
+}
+
+func transformProgramRequirementModelToProgramRequirementEntity(model *models.ProgramRequirementModel) *repositories.ProgramRequirementEntity {
+	courses := make([]*repositories.RequirementCourseEntity, 0, len(model.CourseIDs))
+	for _, courseID := range model.CourseIDs {
+		courses = append(courses, &repositories.RequirementCourseEntity{ID: courseID})
+	}
+	return &repositories.ProgramRequirementEntity{
+		Name:     model.Name,
+		Kind:     model.Kind,
+		Credits:  model.Credits,
+		MinGrade: model.MinGrade,
+		Courses:  courses,
+	}
+}
+
+func (_self Program) UpdateProgramRequirement(id string, requirementID string, requirement *models.ProgramRequirementModel) error {
+	if err := checkMinGrade(_self.GradeScale, requirement.MinGrade); err != nil {
+		return err
+	}
+	return _self.ProgramRepositories.UpdateProgramRequirement(id, requirementID,
+		transformProgramRequirementModelToProgramRequirementEntity(requirement))
+}
+
+func (_self Program) DeleteProgramRequirement(id string, requirementID string) error {
+	return _self.ProgramRepositories.DeleteProgramRequirement(id, requirementID)
+}
+
+// checkMinGrade rejects a minimum grade that isn't a letter of the grade scale
+func checkMinGrade(scale *models.GradeScaleModel, minGrade *string) error {
+	if minGrade == nil {
+		return nil
+	}
+	if _, ok := scale.Letters[*minGrade]; !ok {
+		return models.ErrInvalidGrade
+	}
+	return nil
+}
+
+// checkProgram returns ErrProgramNotFound when a student refers to a program that doesn't exist,
+// a student doesn't have to be in a program
+func checkProgram(programRepositories repositories.ProgramRepositories, programID *int) error {
+	if programID == nil {
+		return nil
+	}
+	_, err := programRepositories.GetProgramByID(strconv.Itoa(*programID))
+	return err
+}
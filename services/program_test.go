@@ -0,0 +1,108 @@
+package services
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockProgramRepository struct {
+	mock.Mock
+}
+
+func (m *MockProgramRepository) CreateProgram(program *repositories.ProgramEntity) (*repositories.ProgramEntity, error) {
+	returnArgs := m.Called(program)
+	return returnArgs.Get(0).(*repositories.ProgramEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramRepository) GetPrograms(filter *models.ProgramFilterModel) ([]*repositories.ProgramEntity, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*repositories.ProgramEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramRepository) GetProgramByID(id string) (*repositories.ProgramEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.ProgramEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramRepository) UpdateProgram(id string, program *repositories.ProgramEntity) error {
+	returnArgs := m.Called(id, program)
+	return returnArgs.Error(0)
+}
+
+func (m *MockProgramRepository) DeleteProgram(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+func (m *MockProgramRepository) GetProgramRequirements(id string) ([]*repositories.ProgramRequirementEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*repositories.ProgramRequirementEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramRepository) CreateProgramRequirement(id string, requirement *repositories.ProgramRequirementEntity) (*repositories.ProgramRequirementEntity, error) {
+	returnArgs := m.Called(id, requirement)
+	return returnArgs.Get(0).(*repositories.ProgramRequirementEntity), returnArgs.Error(1)
+}
+
+func (m *MockProgramRepository) UpdateProgramRequirement(id string, requirementID string, requirement *repositories.ProgramRequirementEntity) error {
+	returnArgs := m.Called(id, requirementID, requirement)
+	return returnArgs.Error(0)
+}
+
+func (m *MockProgramRepository) DeleteProgramRequirement(id string, requirementID string) error {
+	returnArgs := m.Called(id, requirementID)
+	return returnArgs.Error(0)
+}
+
+func Test_CreateProgram(t *testing.T) {
+	var noDepartment *repositories.DepartmentEntity
+
+	testCases := []struct {
+		name           string
+		input          *models.ProgramModel
+		expectedValue  *repositories.ProgramEntity
+		expectedError  error
+		mockRepoInput  *repositories.ProgramEntity
+		mockRepoResult *repositories.ProgramEntity
+	}{
+		{
+			name:          "department not found",
+			input:         &models.ProgramModel{DepartmentID: 9, Code: "BSC-MATH", Name: "Mathematics", Degree: "BSc"},
+			expectedError: models.ErrDepartmentNotFound,
+		},
+		{
+			name:           "create program successfully",
+			input:          &models.ProgramModel{DepartmentID: 1, Code: "BSC-MATH", Name: "Mathematics", Degree: "BSc"},
+			expectedValue:  &repositories.ProgramEntity{ID: 1, DepartmentID: 1, Code: "BSC-MATH", Name: "Mathematics", Degree: "BSc"},
+			mockRepoInput:  &repositories.ProgramEntity{DepartmentID: 1, Code: "BSC-MATH", Name: "Mathematics", Degree: "BSc"},
+			mockRepoResult: &repositories.ProgramEntity{ID: 1, DepartmentID: 1, Code: "BSC-MATH", Name: "Mathematics", Degree: "BSc"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockProgramRepository)
+			mockRepo.On("CreateProgram", testCase.mockRepoInput).Return(testCase.mockRepoResult, nil)
+			mockDepartmentRepo := new(MockDepartmentRepository)
+			mockDepartmentRepo.On("GetDepartmentByID", "1").Return(&repositories.DepartmentEntity{ID: 1, Code: "MATH"}, nil)
+			mockDepartmentRepo.On("GetDepartmentByID", "9").Return(noDepartment, models.ErrDepartmentNotFound)
+
+			programService := Program{
+				ProgramRepositories:    mockRepo,
+				DepartmentRepositories: mockDepartmentRepo,
+			}
+
+			result, err := programService.CreateProgram(testCase.input)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
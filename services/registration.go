@@ -0,0 +1,39 @@
+package services
+
+import (
+	"student_rest/models"
+	"time"
+)
+
+// checkRegistrationWindow returns a RegistrationWindowError when now is outside the course registration window.
+// A course without open or close time is open on that side.
+func checkRegistrationWindow(course *models.CourseModel, now time.Time) error {
+	if course.RegistrationOpensAt != nil {
+		opensAt, err := time.Parse(time.RFC3339, *course.RegistrationOpensAt)
+		if err != nil {
+			return err
+		}
+		if now.Before(opensAt) {
+			return &models.RegistrationWindowError{
+				Code:     models.RegistrationNotOpen,
+				OpensAt:  course.RegistrationOpensAt,
+				ClosesAt: course.RegistrationClosesAt,
+			}
+		}
+	}
+
+	if course.RegistrationClosesAt != nil {
+		closesAt, err := time.Parse(time.RFC3339, *course.RegistrationClosesAt)
+		if err != nil {
+			return err
+		}
+		if !now.Before(closesAt) {
+			return &models.RegistrationWindowError{
+				Code:     models.RegistrationClosed,
+				OpensAt:  course.RegistrationOpensAt,
+				ClosesAt: course.RegistrationClosesAt,
+			}
+		}
+	}
+	return nil
+}
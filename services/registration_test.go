@@ -0,0 +1,65 @@
+package services
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"testing"
+	"time"
+)
+
+func Test_CheckRegistrationWindow(t *testing.T) {
+	opensAt := "2020-10-01T00:00:00Z"
+	closesAt := "2020-11-01T00:00:00Z"
+	now := time.Date(2020, 10, 15, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		inputCourse   *models.CourseModel
+		inputNow      time.Time
+		expectedError error
+	}{
+		{
+			name:          "course without window",
+			inputCourse:   &models.CourseModel{ID: 1},
+			inputNow:      now,
+			expectedError: nil,
+		},
+		{
+			name:          "inside window",
+			inputCourse:   &models.CourseModel{ID: 1, RegistrationOpensAt: &opensAt, RegistrationClosesAt: &closesAt},
+			inputNow:      now,
+			expectedError: nil,
+		},
+		{
+			name:        "registration not open",
+			inputCourse: &models.CourseModel{ID: 1, RegistrationOpensAt: &opensAt, RegistrationClosesAt: &closesAt},
+			inputNow:    time.Date(2020, 9, 30, 0, 0, 0, 0, time.UTC),
+			expectedError: &models.RegistrationWindowError{
+				Code:     models.RegistrationNotOpen,
+				OpensAt:  &opensAt,
+				ClosesAt: &closesAt,
+			},
+		},
+		{
+			name:        "registration closed at close time",
+			inputCourse: &models.CourseModel{ID: 1, RegistrationClosesAt: &closesAt},
+			inputNow:    time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC),
+			expectedError: &models.RegistrationWindowError{
+				Code:     models.RegistrationClosed,
+				ClosesAt: &closesAt,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkRegistrationWindow(testCase.inputCourse, testCase.inputNow)
+
+			if testCase.expectedError != nil {
+				require.Equal(t, testCase.expectedError, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
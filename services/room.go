@@ -0,0 +1,227 @@
+package services
+
+import (
+	"sort"
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+	"time"
+)
+
+type Room struct {
+	repositories.RoomRepositories
+}
+
+type RoomServices interface {
+	CreateRoom(room *models.RoomModel) (*repositories.RoomEntity, error)
+	GetRooms() ([]*repositories.RoomEntity, error)
+	GetRoomByID(id string) (*repositories.RoomEntity, error)
+	UpdateRoom(id string, room *models.RoomModel) error
+	DeleteRoom(id string) error
+	GetFreeRooms(filter *models.RoomFilterModel) ([]*repositories.RoomEntity, error)
+}
+
+func (_self Room) CreateRoom(room *models.RoomModel) (*repositories.RoomEntity, error) {
+	return _self.RoomRepositories.CreateRoom(transformRoomModelToRoomEntity(room))
+}
+
+func transformRoomModelToRoomEntity(model *models.RoomModel) *repositories.RoomEntity {
+	features := model.Features
+	if features == nil {
+		features = []string{}
+	}
+	return &repositories.RoomEntity{
+		Building: model.Building,
+		Number:   model.Number,
+		Capacity: model.Capacity,
+		Features: features,
+	}
+}
+
+func (_self Room) GetRooms() ([]*repositories.RoomEntity, error) {
+	return _self.RoomRepositories.GetRooms()
+}
+
+func (_self Room) GetRoomByID(id string) (*repositories.RoomEntity, error) {
+	return _self.RoomRepositories.GetRoomByID(id)
+}
+
+// UpdateRoom updates the room, it must still seat the capacity of every course booked in it
+func (_self Room) UpdateRoom(id string, room *models.RoomModel) error {
+	courses, err := _self.RoomRepositories.GetRoomCourses(id)
+	if err != nil {
+		return err
+	}
+	if err = checkCourseCapacities(room.Capacity, courses); err != nil {
+		return err
+	}
+	return _self.RoomRepositories.UpdateRoom(id, transformRoomModelToRoomEntity(room))
+}
+
+func (_self Room) DeleteRoom(id string) error {
+	return _self.RoomRepositories.DeleteRoom(id)
+}
+
+func (_self Room) GetFreeRooms(filter *models.RoomFilterModel) ([]*repositories.RoomEntity, error) {
+	return _self.RoomRepositories.GetFreeRooms(filter)
+}
+
+// roomBookingRules holds the checks run before a course or one of its sessions takes a room
+type roomBookingRules struct {
+	CourseRepositories repositories.CourseRepositories
+	RoomRepositories   repositories.RoomRepositories
+}
+
+// checkCapacity rejects the room when it can't seat the capacity of the course or the students enrolled in it
+func (_self roomBookingRules) checkCapacity(courseID string, course *models.CourseModel, room *repositories.RoomEntity) error {
+	if err := checkCourseCapacities(room.Capacity, []*models.CourseModel{course}); err != nil {
+		return err
+	}
+	enrolled, err := _self.CourseRepositories.GetEnrolledCount(courseID)
+	if err != nil {
+		return err
+	}
+	if enrolled > room.Capacity {
+		return &models.RoomCapacityExceededError{Capacity: room.Capacity, Enrolled: enrolled}
+	}
+	return nil
+}
+
+// checkCourseCapacities returns a RoomTooSmallError with the courses whose capacity is more than the room seats,
+// a course without capacity fits any room
+func checkCourseCapacities(capacity int, courses []*models.CourseModel) error {
+	tooLarge := []*models.CourseModel{}
+	for _, course := range courses {
+		if course.Capacity != nil && *course.Capacity > capacity {
+			tooLarge = append(tooLarge, course)
+		}
+	}
+	if len(tooLarge) > 0 {
+		return &models.RoomTooSmallError{Capacity: capacity, Courses: tooLarge}
+	}
+	return nil
+}
+
+// check rejects the rooms booked by the course when another course takes one of them at the time of a session.
+// sessions are the sessions the course will have, a course without sessions takes its room for its whole time span.
+func (_self roomBookingRules) check(courseID int, course *models.CourseModel, sessions []*repositories.SessionEntity,
+	bookings []*repositories.CourseRoomEntity) error {
+	spans, err := bookedSpans(course, sessions, bookings)
+	if err != nil {
+		return err
+	}
+
+	roomIDs := make([]int, 0, len(spans))
+	for roomID := range spans {
+		roomIDs = append(roomIDs, roomID)
+	}
+	sort.Ints(roomIDs)
+
+	conflicts := []*models.CourseModel{}
+	conflicting := map[int]bool{}
+	for _, roomID := range roomIDs {
+		from, to := spansBounds(spans[roomID])
+		taken, err := _self.RoomRepositories.GetRoomBookings(roomID, from.Format(time.RFC3339), to.Format(time.RFC3339))
+		if err != nil {
+			return err
+		}
+
+		for _, booking := range taken {
+			if booking.CourseID == courseID || conflicting[booking.CourseID] {
+				continue
+			}
+			start, err := time.Parse(time.RFC3339, booking.StartTime)
+			if err != nil {
+				return err
+			}
+			end, err := time.Parse(time.RFC3339, booking.EndTime)
+			if err != nil {
+				return err
+			}
+			if spansOverlap(spans[roomID], []timeSpan{{start: start, end: end}}) {
+				conflicting[booking.CourseID] = true
+				conflicts = append(conflicts, &models.CourseModel{ID: booking.CourseID, Name: booking.CourseName})
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return &models.RoomConflictError{Courses: conflicts}
+	}
+	return nil
+}
+
+// checkCourse checks the rooms the course already booked against its capacity and the sessions it will have
+func (_self roomBookingRules) checkCourse(id string, course *models.CourseModel, sessions []*repositories.SessionEntity) error {
+	courseID, err := strconv.Atoi(id)
+	if err != nil {
+		return models.ErrCourseNotFound
+	}
+	bookings, err := _self.RoomRepositories.GetCourseRooms(courseID)
+	if err != nil || len(bookings) == 0 {
+		return err
+	}
+	for _, booking := range bookings {
+		if err = checkCourseCapacities(booking.Room.Capacity, []*models.CourseModel{course}); err != nil {
+			return err
+		}
+	}
+	return _self.check(courseID, course, sessions, bookings)
+}
+
+// bookedSpans groups the times the course takes rooms by room. A session is in the room booked for its date,
+// or else in the room booked for the whole course.
+func bookedSpans(course *models.CourseModel, sessions []*repositories.SessionEntity,
+	bookings []*repositories.CourseRoomEntity) (map[int][]timeSpan, error) {
+	courseRoom := 0
+	sessionRooms := map[string]int{}
+	for _, booking := range bookings {
+		if booking.Date == nil {
+			courseRoom = booking.Room.ID
+		} else {
+			sessionRooms[*booking.Date] = booking.Room.ID
+		}
+	}
+
+	spans := map[int][]timeSpan{}
+	if len(sessions) == 0 {
+		if courseRoom == 0 {
+			return spans, nil
+		}
+		courseSpan, err := courseSpans(course, nil)
+		if err != nil {
+			return nil, err
+		}
+		spans[courseRoom] = courseSpan
+		return spans, nil
+	}
+
+	for _, session := range sessions {
+		roomID, ok := sessionRooms[session.Date]
+		if !ok {
+			roomID = courseRoom
+		}
+		if roomID == 0 {
+			continue
+		}
+		sessionSpan, err := courseSpans(course, []*repositories.SessionEntity{session})
+		if err != nil {
+			return nil, err
+		}
+		spans[roomID] = append(spans[roomID], sessionSpan...)
+	}
+	return spans, nil
+}
+
+func spansBounds(spans []timeSpan) (time.Time, time.Time) {
+	from, to := spans[0].start, spans[0].end
+	for _, span := range spans[1:] {
+		if span.start.Before(from) {
+			from = span.start
+		}
+		if span.end.After(to) {
+			to = span.end
+		}
+	}
+	return from, to
+}
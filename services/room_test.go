@@ -0,0 +1,276 @@
+package services
+
+import (
+	"errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockRoomRepository struct {
+	mock.Mock
+}
+
+func (m *MockRoomRepository) CreateRoom(room *repositories.RoomEntity) (*repositories.RoomEntity, error) {
+	returnArgs := m.Called(room)
+	return returnArgs.Get(0).(*repositories.RoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomRepository) GetRooms() ([]*repositories.RoomEntity, error) {
+	returnArgs := m.Called()
+	return returnArgs.Get(0).([]*repositories.RoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomRepository) GetRoomByID(id string) (*repositories.RoomEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.RoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomRepository) UpdateRoom(id string, room *repositories.RoomEntity) error {
+	returnArgs := m.Called(id, room)
+	return returnArgs.Error(0)
+}
+
+func (m *MockRoomRepository) DeleteRoom(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+func (m *MockRoomRepository) GetFreeRooms(filter *models.RoomFilterModel) ([]*repositories.RoomEntity, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*repositories.RoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomRepository) GetRoomBookings(roomID int, from string, to string) ([]*repositories.RoomBookingEntity, error) {
+	returnArgs := m.Called(roomID, from, to)
+	return returnArgs.Get(0).([]*repositories.RoomBookingEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomRepository) GetCourseRooms(courseID int) ([]*repositories.CourseRoomEntity, error) {
+	returnArgs := m.Called(courseID)
+	return returnArgs.Get(0).([]*repositories.CourseRoomEntity), returnArgs.Error(1)
+}
+
+func (m *MockRoomRepository) GetRoomCourses(id string) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
+func (m *MockRoomRepository) BookRoom(courseID int, roomID int, date *string) error {
+	returnArgs := m.Called(courseID, roomID, date)
+	return returnArgs.Error(0)
+}
+
+func (m *MockRoomRepository) DeleteRoomBooking(courseID int, date *string) error {
+	returnArgs := m.Called(courseID, date)
+	return returnArgs.Error(0)
+}
+
+func Test_CreateRoom(t *testing.T) {
+	mockRepo := new(MockRoomRepository)
+	mockRepo.On("CreateRoom", &repositories.RoomEntity{Building: "A", Number: "101", Capacity: 30, Features: []string{}}).
+		Return(&repositories.RoomEntity{ID: 1, Building: "A", Number: "101", Capacity: 30, Features: []string{}}, nil)
+
+	roomService := Room{
+		RoomRepositories: mockRepo,
+	}
+
+	result, err := roomService.CreateRoom(&models.RoomModel{Building: "A", Number: "101", Capacity: 30})
+
+	require.NoError(t, err)
+	require.Equal(t, &repositories.RoomEntity{ID: 1, Building: "A", Number: "101", Capacity: 30, Features: []string{}}, result)
+}
+
+func Test_UpdateRoom(t *testing.T) {
+	smallCapacity, largeCapacity := 20, 40
+	math := &models.CourseModel{ID: 1, Name: "Math", Capacity: &smallCapacity}
+	physics := &models.CourseModel{ID: 2, Name: "Physics", Capacity: &largeCapacity}
+	chemistry := &models.CourseModel{ID: 3, Name: "Chemistry"}
+
+	testCases := []struct {
+		name               string
+		input              *models.RoomModel
+		expectedError      error
+		mockCourses        []*models.CourseModel
+		mockCoursesError   error
+		expectedUpdateRoom bool
+	}{
+		{
+			name:             "get room courses fail",
+			input:            &models.RoomModel{Building: "A", Number: "101", Capacity: 30},
+			expectedError:    errors.New("get room courses fail"),
+			mockCourses:      []*models.CourseModel{},
+			mockCoursesError: errors.New("get room courses fail"),
+		},
+		{
+			name:          "room seats fewer than the capacity of a booked course",
+			input:         &models.RoomModel{Building: "A", Number: "101", Capacity: 30},
+			expectedError: &models.RoomTooSmallError{Capacity: 30, Courses: []*models.CourseModel{physics}},
+			mockCourses:   []*models.CourseModel{math, physics, chemistry},
+		},
+		{
+			name:               "update room successfully",
+			input:              &models.RoomModel{Building: "A", Number: "101", Capacity: 40},
+			mockCourses:        []*models.CourseModel{math, physics, chemistry},
+			expectedUpdateRoom: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockRoomRepository)
+			mockRepo.On("GetRoomCourses", "1").Return(testCase.mockCourses, testCase.mockCoursesError)
+			mockRepo.On("UpdateRoom", "1", mock.Anything).Return(nil)
+
+			roomService := Room{
+				RoomRepositories: mockRepo,
+			}
+
+			err := roomService.UpdateRoom("1", testCase.input)
+
+			if testCase.expectedError != nil {
+				require.Equal(t, testCase.expectedError, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if testCase.expectedUpdateRoom {
+				mockRepo.AssertCalled(t, "UpdateRoom", "1", transformRoomModelToRoomEntity(testCase.input))
+			} else {
+				mockRepo.AssertNotCalled(t, "UpdateRoom", "1", mock.Anything)
+			}
+		})
+	}
+}
+
+func Test_BookRoom(t *testing.T) {
+	capacity := 40
+	math := &models.CourseModel{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-10T00:00:00Z"}
+	mondays := []*repositories.SessionEntity{
+		{CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+		{CourseID: 1, Date: "2020-11-09", StartTime: "2020-11-09T09:00:00Z", EndTime: "2020-11-09T10:30:00Z"},
+	}
+	small := &repositories.RoomEntity{ID: 1, Building: "A", Number: "101", Capacity: 10}
+	large := &repositories.RoomEntity{ID: 2, Building: "B", Number: "201", Capacity: 80}
+	var noRoom *repositories.RoomEntity
+	sessionDate := "2020-11-09"
+	var noDate *string
+
+	testCases := []struct {
+		name             string
+		inputDate        string
+		inputRoomID      int
+		expectedError    error
+		expectedBookDate *string
+		mockCourse       *models.CourseModel
+		mockRoom         *repositories.RoomEntity
+		mockRoomError    error
+		mockSessions     []*repositories.SessionEntity
+		mockCourseRooms  []*repositories.CourseRoomEntity
+		mockRoomBookings []*repositories.RoomBookingEntity
+		expectedBookRoom bool
+	}{
+		{
+			name:          "room not found",
+			inputRoomID:   9,
+			expectedError: models.ErrRoomNotFound,
+			mockRoom:      noRoom,
+			mockRoomError: models.ErrRoomNotFound,
+		},
+		{
+			name:          "no session on the date",
+			inputDate:     "2020-11-03",
+			inputRoomID:   2,
+			expectedError: models.ErrSessionNotScheduled,
+			mockRoom:      large,
+			mockSessions:  mondays,
+		},
+		{
+			name:          "room seats fewer than the capacity of the course",
+			inputRoomID:   1,
+			expectedError: &models.RoomTooSmallError{Capacity: 10, Courses: []*models.CourseModel{{ID: 1, Name: "Math", Capacity: &capacity}}},
+			mockCourse:    &models.CourseModel{ID: 1, Name: "Math", Capacity: &capacity},
+			mockRoom:      small,
+			mockSessions:  mondays,
+		},
+		{
+			name:          "room seats fewer students than enrolled",
+			inputRoomID:   1,
+			expectedError: &models.RoomCapacityExceededError{Capacity: 10, Enrolled: 25},
+			mockRoom:      small,
+			mockSessions:  mondays,
+		},
+		{
+			name:          "another course takes the room at the time of a session",
+			inputRoomID:   2,
+			expectedError: &models.RoomConflictError{Courses: []*models.CourseModel{{ID: 3, Name: "Physics"}}},
+			mockRoom:      large,
+			mockSessions:  mondays,
+			mockRoomBookings: []*repositories.RoomBookingEntity{
+				{RoomID: 2, CourseID: 3, CourseName: "Physics", StartTime: "2020-11-09T10:00:00Z", EndTime: "2020-11-09T11:00:00Z"},
+			},
+		},
+		{
+			name:             "book the room for the course successfully",
+			inputRoomID:      2,
+			expectedBookDate: noDate,
+			mockRoom:         large,
+			mockSessions:     mondays,
+			mockRoomBookings: []*repositories.RoomBookingEntity{
+				{RoomID: 2, CourseID: 3, CourseName: "Physics", StartTime: "2020-11-02T11:00:00Z", EndTime: "2020-11-02T12:00:00Z"},
+			},
+			expectedBookRoom: true,
+		},
+		{
+			name:             "book a room for one session successfully",
+			inputDate:        "2020-11-09",
+			inputRoomID:      2,
+			expectedBookDate: &sessionDate,
+			mockRoom:         large,
+			mockSessions:     mondays,
+			mockCourseRooms:  []*repositories.CourseRoomEntity{{CourseID: 1, Room: large}},
+			mockRoomBookings: []*repositories.RoomBookingEntity{
+				{RoomID: 2, CourseID: 1, CourseName: "Math", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+			},
+			expectedBookRoom: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MocCourseRepository)
+			course := math
+			if testCase.mockCourse != nil {
+				course = testCase.mockCourse
+			}
+			mockRepo.On("GetCourseByID", "1").Return(course, nil)
+			mockRepo.On("GetCourseSessions", "1").Return(testCase.mockSessions, nil)
+			mockRepo.On("GetEnrolledCount", "1").Return(25, nil)
+
+			mockRoomRepo := new(MockRoomRepository)
+			mockRoomRepo.On("GetRoomByID", mock.Anything).Return(testCase.mockRoom, testCase.mockRoomError)
+			mockRoomRepo.On("GetCourseRooms", 1).Return(testCase.mockCourseRooms, nil)
+			mockRoomRepo.On("GetRoomBookings", 2, mock.Anything, mock.Anything).Return(testCase.mockRoomBookings, nil)
+			mockRoomRepo.On("BookRoom", 1, testCase.inputRoomID, mock.Anything).Return(nil)
+
+			courseService := Course{
+				CourseRepositories: mockRepo,
+				RoomRepositories:   mockRoomRepo,
+			}
+
+			err := courseService.BookRoom("1", testCase.inputDate, testCase.inputRoomID)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if testCase.expectedBookRoom {
+				mockRoomRepo.AssertCalled(t, "BookRoom", 1, testCase.inputRoomID, testCase.expectedBookDate)
+			} else {
+				mockRoomRepo.AssertNotCalled(t, "BookRoom", 1, testCase.inputRoomID, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+	"time"
+)
+
+type timeSpan struct {
+	start time.Time
+	end   time.Time
+}
+
+// findScheduleConflicts returns the courses whose time span overlaps the given course
+func findScheduleConflicts(course *models.CourseModel, courses []*models.CourseModel) ([]*models.CourseModel, error) {
+	start, end, err := parseCourseTimes(course)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := []*models.CourseModel{}
+	for _, other := range courses {
+		if other.ID == course.ID {
+			continue
+		}
+		otherStart, otherEnd, err := parseCourseTimes(other)
+		if err != nil {
+			return nil, err
+		}
+		if start.Before(otherEnd) && otherStart.Before(end) {
+			conflicts = append(conflicts, other)
+		}
+	}
+	return conflicts, nil
+}
+
+func parseCourseTimes(course *models.CourseModel) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, course.StartTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := time.Parse(time.RFC3339, course.EndTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+// findSessionConflicts returns the courses whose sessions overlap the given sessions of the course. Only the courses
+// whose time spans overlap are compared, a course without sessions takes its whole time span.
+func findSessionConflicts(courseRepositories repositories.CourseRepositories, course *models.CourseModel,
+	sessions []*repositories.SessionEntity, courses []*models.CourseModel) ([]*models.CourseModel, error) {
+	candidates, err := findScheduleConflicts(course, courses)
+	if err != nil || len(candidates) == 0 {
+		return candidates, err
+	}
+
+	spans, err := courseSpans(course, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := []*models.CourseModel{}
+	for _, candidate := range candidates {
+		candidateSessions, err := courseRepositories.GetCourseSessions(strconv.Itoa(candidate.ID))
+		if err != nil {
+			return nil, err
+		}
+		candidateSpans, err := courseSpans(candidate, candidateSessions)
+		if err != nil {
+			return nil, err
+		}
+		if spansOverlap(spans, candidateSpans) {
+			conflicts = append(conflicts, candidate)
+		}
+	}
+	return conflicts, nil
+}
+
+// courseSpans is the time of every session of the course, or its whole time span when it has no sessions
+func courseSpans(course *models.CourseModel, sessions []*repositories.SessionEntity) ([]timeSpan, error) {
+	if len(sessions) == 0 {
+		start, end, err := parseCourseTimes(course)
+		if err != nil {
+			return nil, err
+		}
+		return []timeSpan{{start: start, end: end}}, nil
+	}
+
+	spans := make([]timeSpan, 0, len(sessions))
+	for _, session := range sessions {
+		start, err := time.Parse(time.RFC3339, session.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		end, err := time.Parse(time.RFC3339, session.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, timeSpan{start: start, end: end})
+	}
+	return spans, nil
+}
+
+func spansOverlap(spans []timeSpan, others []timeSpan) bool {
+	for _, span := range spans {
+		for _, other := range others {
+			if span.start.Before(other.end) && other.start.Before(span.end) {
+				return true
+			}
+		}
+	}
+	return false
+}
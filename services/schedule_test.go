@@ -0,0 +1,128 @@
+package services
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+func Test_FindScheduleConflicts(t *testing.T) {
+	math := &models.CourseModel{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"}
+	physics := &models.CourseModel{ID: 2, Name: "Physics", StartTime: "2020-11-02T12:00:00Z", EndTime: "2020-11-04T00:00:00Z"}
+	chemistry := &models.CourseModel{ID: 3, Name: "Chemistry", StartTime: "2020-11-03T00:00:00Z", EndTime: "2020-11-04T00:00:00Z"}
+
+	testCases := []struct {
+		name          string
+		inputCourse   *models.CourseModel
+		inputCourses  []*models.CourseModel
+		expectedValue []*models.CourseModel
+		expectedError error
+	}{
+		{
+			name:          "parse course time fail",
+			inputCourse:   &models.CourseModel{ID: 4, StartTime: "11/2/2020"},
+			inputCourses:  []*models.CourseModel{math},
+			expectedError: errors.New("parsing time \"11/2/2020\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"11/2/2020\" as \"2006\""),
+		},
+		{
+			name:          "overlapping courses",
+			inputCourse:   physics,
+			inputCourses:  []*models.CourseModel{math, chemistry},
+			expectedValue: []*models.CourseModel{math, chemistry},
+		},
+		{
+			name:          "adjacent courses don't overlap",
+			inputCourse:   chemistry,
+			inputCourses:  []*models.CourseModel{math},
+			expectedValue: []*models.CourseModel{},
+		},
+		{
+			name:          "same course is ignored",
+			inputCourse:   math,
+			inputCourses:  []*models.CourseModel{math},
+			expectedValue: []*models.CourseModel{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := findScheduleConflicts(testCase.inputCourse, testCase.inputCourses)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_FindSessionConflicts(t *testing.T) {
+	math := &models.CourseModel{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-30T00:00:00Z"}
+	physics := &models.CourseModel{ID: 2, Name: "Physics", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-30T00:00:00Z"}
+	chemistry := &models.CourseModel{ID: 3, Name: "Chemistry", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-30T00:00:00Z"}
+	history := &models.CourseModel{ID: 4, Name: "History", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-30T00:00:00Z"}
+	mathSessions := []*repositories.SessionEntity{
+		{StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:00:00Z"},
+		{StartTime: "2020-11-09T09:00:00Z", EndTime: "2020-11-09T10:00:00Z"},
+	}
+
+	testCases := []struct {
+		name          string
+		inputSessions []*repositories.SessionEntity
+		inputCourses  []*models.CourseModel
+		expectedValue []*models.CourseModel
+		expectedError error
+	}{
+		{
+			name:          "concurrent courses meeting at other times don't conflict",
+			inputSessions: mathSessions,
+			inputCourses:  []*models.CourseModel{physics},
+			expectedValue: []*models.CourseModel{},
+		},
+		{
+			name:          "overlapping sessions",
+			inputSessions: mathSessions,
+			inputCourses:  []*models.CourseModel{physics, chemistry},
+			expectedValue: []*models.CourseModel{chemistry},
+		},
+		{
+			name:          "course without sessions takes its whole time span",
+			inputSessions: mathSessions,
+			inputCourses:  []*models.CourseModel{history},
+			expectedValue: []*models.CourseModel{history},
+		},
+		{
+			name:          "get course sessions fail",
+			inputSessions: mathSessions,
+			inputCourses:  []*models.CourseModel{{ID: 5, StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-30T00:00:00Z"}},
+			expectedError: errors.New("get course sessions fail"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MocCourseRepository)
+			mockRepo.On("GetCourseSessions", "2").Return([]*repositories.SessionEntity{
+				{StartTime: "2020-11-02T11:00:00Z", EndTime: "2020-11-02T12:00:00Z"},
+			}, nil)
+			mockRepo.On("GetCourseSessions", "3").Return([]*repositories.SessionEntity{
+				{StartTime: "2020-11-09T09:30:00Z", EndTime: "2020-11-09T10:30:00Z"},
+			}, nil)
+			mockRepo.On("GetCourseSessions", "4").Return([]*repositories.SessionEntity{}, nil)
+			mockRepo.On("GetCourseSessions", "5").Return([]*repositories.SessionEntity(nil), errors.New("get course sessions fail"))
+
+			result, err := findSessionConflicts(mockRepo, math, testCase.inputSessions, testCase.inputCourses)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"student_rest/models"
+	"student_rest/repositories"
+	"time"
+)
+
+// generateSessions lays the weekly meeting pattern over every day from the first to the last day of the course in the
+// pattern's time zone, skipping the excluded dates and the sessions that don't fit between the start and end of the course.
+// Sessions start at the wall clock time of the pattern's time zone, so they keep their local time when daylight
+// saving time starts or ends.
+func generateSessions(course *models.CourseModel, pattern *repositories.MeetingPatternEntity) ([]*repositories.SessionEntity, error) {
+	location, err := time.LoadLocation(pattern.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	clock, err := time.Parse("15:04", pattern.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := time.Parse(time.RFC3339, course.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := time.Parse(time.RFC3339, course.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	startTime, endTime = startTime.In(location), endTime.In(location)
+
+	days := map[time.Weekday]bool{}
+	for _, day := range pattern.DaysOfWeek {
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			if strings.EqualFold(weekday.String(), day) {
+				days[weekday] = true
+			}
+		}
+	}
+	excluded := map[string]bool{}
+	for _, date := range pattern.ExcludedDates {
+		excluded[date] = true
+	}
+	duration := time.Duration(pattern.DurationMinutes) * time.Minute
+
+	sessions := []*repositories.SessionEntity{}
+	lastDay := time.Date(endTime.Year(), endTime.Month(), endTime.Day(), 0, 0, 0, 0, time.UTC)
+	for day := time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 0, 0, 0, 0, time.UTC); !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		if !days[day.Weekday()] || excluded[date] {
+			continue
+		}
+
+		start := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, location)
+		end := start.Add(duration)
+		if start.Before(startTime) || end.After(endTime) {
+			continue
+		}
+		sessions = append(sessions, &repositories.SessionEntity{
+			CourseID:  pattern.CourseID,
+			Date:      date,
+			StartTime: start.UTC().Format(time.RFC3339),
+			EndTime:   end.UTC().Format(time.RFC3339),
+		})
+	}
+
+	if len(sessions) == 0 {
+		return nil, models.ErrNoSessions
+	}
+	return sessions, nil
+}
+
+// hasSessionOn reports whether one of the sessions is held on the YYYY-MM-DD date
+func hasSessionOn(sessions []*repositories.SessionEntity, date string) bool {
+	for _, session := range sessions {
+		if session.Date == date {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduleUIDDomain ends every calendar event UID so the UIDs stay unique across calendars
+const scheduleUIDDomain = "@student-rest"
+
+// scheduleEvents lists the sessions of the courses ordered by start time, a course without sessions is one event.
+// The UIDs depend only on the course and the session start so calendar clients keep recognising the events.
+func scheduleEvents(courseRepositories repositories.CourseRepositories, courses []*models.CourseModel) ([]*models.ScheduleEventModel, error) {
+	events := []*models.ScheduleEventModel{}
+	for _, course := range courses {
+		sessions, err := courseRepositories.GetCourseSessions(strconv.Itoa(course.ID))
+		if err != nil {
+			return nil, err
+		}
+
+		teacher := ""
+		if course.Teacher != nil {
+			teacher = strings.TrimSpace(course.Teacher.FirstName + " " + course.Teacher.LastName)
+		}
+		courseUID := "course-" + strconv.Itoa(course.ID)
+
+		if len(sessions) == 0 {
+			events = append(events, &models.ScheduleEventModel{
+				UID:        courseUID + scheduleUIDDomain,
+				CourseID:   course.ID,
+				CourseName: course.Name,
+				Teacher:    teacher,
+				StartTime:  course.StartTime,
+				EndTime:    course.EndTime,
+			})
+			continue
+		}
+
+		for _, session := range sessions {
+			startTime, err := time.Parse(time.RFC3339, session.StartTime)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, &models.ScheduleEventModel{
+				UID:        courseUID + "-" + startTime.UTC().Format("20060102T150405Z") + scheduleUIDDomain,
+				CourseID:   course.ID,
+				CourseName: course.Name,
+				Teacher:    teacher,
+				StartTime:  session.StartTime,
+				EndTime:    session.EndTime,
+			})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].StartTime < events[j].StartTime
+	})
+	return events, nil
+}
+
+// eventsBetween keeps the events overlapping the UTC days of the filter, a missing bound doesn't limit the events
+func eventsBetween(events []*models.ScheduleEventModel, filter *models.ScheduleFilterModel) ([]*models.ScheduleEventModel, error) {
+	var from, to time.Time
+	var err error
+	if filter.From != "" {
+		if from, err = time.Parse("2006-01-02", filter.From); err != nil {
+			return nil, err
+		}
+	}
+	if filter.To != "" {
+		if to, err = time.Parse("2006-01-02", filter.To); err != nil {
+			return nil, err
+		}
+		// The last day is included
+		to = to.AddDate(0, 0, 1)
+	}
+
+	result := []*models.ScheduleEventModel{}
+	for _, event := range events {
+		startTime, err := time.Parse(time.RFC3339, event.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		endTime, err := time.Parse(time.RFC3339, event.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		if filter.From != "" && !endTime.After(from) {
+			continue
+		}
+		if filter.To != "" && !startTime.Before(to) {
+			continue
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
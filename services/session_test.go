@@ -0,0 +1,96 @@
+package services
+
+import (
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+func Test_GenerateSessions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		course        *models.CourseModel
+		pattern       *repositories.MeetingPatternEntity
+		expectedValue []*repositories.SessionEntity
+		expectedError error
+	}{
+		{
+			name:   "sessions keep their local time across daylight saving time",
+			course: &models.CourseModel{ID: 1, StartTime: "2020-10-26T00:00:00Z", EndTime: "2020-11-05T00:00:00Z"},
+			pattern: &repositories.MeetingPatternEntity{
+				CourseID:        1,
+				DaysOfWeek:      []string{"monday", "wednesday"},
+				StartTime:       "09:00",
+				DurationMinutes: 75,
+				TimeZone:        "America/New_York",
+				ExcludedDates:   []string{"2020-10-28"},
+			},
+			expectedValue: []*repositories.SessionEntity{
+				{CourseID: 1, Date: "2020-10-26", StartTime: "2020-10-26T13:00:00Z", EndTime: "2020-10-26T14:15:00Z"},
+				{CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T14:00:00Z", EndTime: "2020-11-02T15:15:00Z"},
+				{CourseID: 1, Date: "2020-11-04", StartTime: "2020-11-04T14:00:00Z", EndTime: "2020-11-04T15:15:00Z"},
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "course days in the time zone of the pattern",
+			course: &models.CourseModel{ID: 1, StartTime: "2020-11-02T03:00:00Z", EndTime: "2020-11-09T03:00:00Z"},
+			pattern: &repositories.MeetingPatternEntity{
+				CourseID:        1,
+				DaysOfWeek:      []string{"monday"},
+				StartTime:       "09:00",
+				DurationMinutes: 60,
+				TimeZone:        "America/New_York",
+				ExcludedDates:   []string{},
+			},
+			expectedValue: []*repositories.SessionEntity{
+				{CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T14:00:00Z", EndTime: "2020-11-02T15:00:00Z"},
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "sessions outside the course dropped",
+			course: &models.CourseModel{ID: 1, StartTime: "2020-11-02T15:00:00Z", EndTime: "2020-11-16T09:30:00Z"},
+			pattern: &repositories.MeetingPatternEntity{
+				CourseID:        1,
+				DaysOfWeek:      []string{"monday"},
+				StartTime:       "09:00",
+				DurationMinutes: 60,
+				TimeZone:        "UTC",
+				ExcludedDates:   []string{},
+			},
+			expectedValue: []*repositories.SessionEntity{
+				{CourseID: 1, Date: "2020-11-09", StartTime: "2020-11-09T09:00:00Z", EndTime: "2020-11-09T10:00:00Z"},
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "every session excluded",
+			course: &models.CourseModel{ID: 1, StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z"},
+			pattern: &repositories.MeetingPatternEntity{
+				CourseID:        1,
+				DaysOfWeek:      []string{"monday"},
+				StartTime:       "09:00",
+				DurationMinutes: 60,
+				TimeZone:        "UTC",
+				ExcludedDates:   []string{"2020-11-02"},
+			},
+			expectedValue: nil,
+			expectedError: models.ErrNoSessions,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := generateSessions(testCase.course, testCase.pattern)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
@@ -1,13 +1,21 @@
 package services
 
 import (
+	"database/sql"
+	"errors"
 	"student_rest/models"
 	"student_rest/repositories"
+	"time"
 )
 
 type Student struct{
 	StudentRepositories repositories.StudentRepositories
+	CourseRepositories repositories.CourseRepositories
+	TermRepositories repositories.TermRepositories
+	ProgramRepositories repositories.ProgramRepositories
 	Utils UtilsService
+	GradeScale *models.GradeScaleModel
+	MaxCreditLoad int
 }
 
 type StudentServices interface {
@@ -16,6 +24,16 @@ type StudentServices interface {
 	DeleteStudent(id string) error
 	UpdateStudent(id string, student *models.StudentModel) error
 	RegisterCourse(registerCourseModel *models.RegisterCourseModel) (*models.RegisterCourseModel, error)
+	EnrollCourse(studentID string, courseID string, options *models.EnrollmentOptionsModel) (*repositories.EnrollmentEntity, error)
+	WithdrawCourse(studentID string, courseID string, withdrawal *models.WithdrawCourseModel) (*repositories.EnrollmentEntity, error)
+	GetStudentCourses(studentID string, filter *models.StudentCourseFilterModel) ([]*models.CourseModel, error)
+	RecordGrade(studentID string, courseID string, grade *models.RecordGradeModel) (*repositories.EnrollmentEntity, error)
+	GetStudentGPA(studentID string) (*models.GPAModel, error)
+	GetStudentTranscript(studentID string) (*repositories.TranscriptEntity, error)
+	SetCreditOverride(studentID string, override *models.CreditOverrideModel) error
+	DeleteCreditOverride(studentID string) error
+	GetStudentSchedule(studentID string) ([]*models.ScheduleEventModel, error)
+	GetStudentAudit(studentID string) (*repositories.AuditEntity, error)
 }
 
 var (
@@ -23,6 +41,10 @@ var (
 )
 
 func (_self Student) CreateStudent(student *models.StudentModel) (*repositories.StudentEntity, error) {
+	if err := checkProgram(_self.ProgramRepositories, student.ProgramID); err != nil {
+		return nil, err
+	}
+
 	studentID, err := _self.Utils.GenerateID(studentRandomIDRegex, 6)
 	if err != nil {
 		return nil, err
@@ -43,6 +65,8 @@ func transformStudentModelToStudentEntity(model *models.StudentModel) *repositor
 		FirstName:   model.FirstName,
 		LastName:    model.LastName,
 		DateOfBirth: model.DateOfBirth,
+
+		ProgramID: model.ProgramID,
 	}
 }
 
@@ -57,12 +81,28 @@ func (_self Student) DeleteStudent(id string) error {
 }
 
 func (_self Student) UpdateStudent(id string, student *models.StudentModel) error {
+	if err := checkProgram(_self.ProgramRepositories, student.ProgramID); err != nil {
+		return err
+	}
 	convertedStudent := transformStudentModelToStudentEntity(student)
 	err := _self.StudentRepositories.UpdateStudent(id, convertedStudent)
 	return err
 }
 
 func (_self Student) RegisterCourse(registerCourseModel *models.RegisterCourseModel) (*models.RegisterCourseModel, error) {
+	// The student is new, so there is no override or credit load for them yet
+	if registerCourseModel.Course != nil {
+		if err := checkCourseInTerm(_self.TermRepositories, registerCourseModel.Course); err != nil {
+			return nil, err
+		}
+		if err := checkRegistrationWindow(registerCourseModel.Course, time.Now()); err != nil {
+			return nil, err
+		}
+		if err := checkCreditLoad(registerCourseModel.Course, nil, _self.MaxCreditLoad); err != nil {
+			return nil, err
+		}
+	}
+
 	studentID, err := _self.Utils.GenerateID(studentRandomIDRegex, 6)
 	if err != nil {
 		return nil, err
@@ -77,3 +117,136 @@ func (_self Student) RegisterCourse(registerCourseModel *models.RegisterCourseMo
 
 	return result, nil
 }
+
+func (_self Student) EnrollCourse(studentID string, courseID string, options *models.EnrollmentOptionsModel) (*repositories.EnrollmentEntity, error) {
+	course, err := getCourse(_self.CourseRepositories, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := enrollmentRules{
+		StudentRepositories: _self.StudentRepositories,
+		CourseRepositories:  _self.CourseRepositories,
+	}
+	if err := rules.check(studentID, course, options); err != nil {
+		return nil, err
+	}
+
+	result, err := _self.StudentRepositories.EnrollCourse(studentID, courseID, options, _self.MaxCreditLoad)
+	return result, err
+}
+
+func (_self Student) WithdrawCourse(studentID string, courseID string, withdrawal *models.WithdrawCourseModel) (*repositories.EnrollmentEntity, error) {
+	if withdrawal.EffectiveDate == "" {
+		withdrawal.EffectiveDate = time.Now().UTC().Format(time.RFC3339)
+	}
+	result, err := _self.StudentRepositories.WithdrawCourse(studentID, courseID, withdrawal, _self.MaxCreditLoad)
+	return result, err
+}
+
+// GetStudentCourses lists the courses the student is enrolled in, filter.Status defaults to active
+func (_self Student) GetStudentCourses(studentID string, filter *models.StudentCourseFilterModel) ([]*models.CourseModel, error) {
+	if filter.Status == "" {
+		filter = &models.StudentCourseFilterModel{
+			From:   filter.From,
+			To:     filter.To,
+			Status: models.EnrollmentStatusActive,
+		}
+	}
+	result, err := _self.StudentRepositories.GetStudentCourses(studentID, filter)
+	return result, err
+}
+
+func (_self Student) RecordGrade(studentID string, courseID string, grade *models.RecordGradeModel) (*repositories.EnrollmentEntity, error) {
+	return recordGrade(_self.StudentRepositories, _self.GradeScale, studentID, courseID, grade)
+}
+
+// GetStudentGPA computes the term and cumulative GPA of the student on the configured grade scale
+func (_self Student) GetStudentGPA(studentID string) (*models.GPAModel, error) {
+	grades, err := _self.StudentRepositories.GetStudentGrades(studentID)
+	if err != nil {
+		return nil, err
+	}
+	return calculateGPA(_self.GradeScale, grades)
+}
+
+// GetStudentTranscript collects the student's enrollments with their term, grade and grade points and the GPA
+func (_self Student) GetStudentTranscript(studentID string) (*repositories.TranscriptEntity, error) {
+	student, err := _self.StudentRepositories.GetStudentByID(studentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	courses, err := _self.StudentRepositories.GetTranscriptCourses(studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	grades := []*models.GradeModel{}
+	for _, course := range courses {
+		if course.GradeLetter == nil && course.GradeScore == nil {
+			continue
+		}
+
+		grade := &models.GradeModel{
+			EnrollmentID: course.EnrollmentID,
+			CourseID:     course.CourseID,
+			CourseName:   course.CourseName,
+			Credits:      course.Credits,
+			Term:         course.Term,
+			StartTime:    course.StartTime,
+			Letter:       course.GradeLetter,
+			Score:        course.GradeScore,
+		}
+		points, err := gradePoints(_self.GradeScale, grade)
+		if err != nil {
+			return nil, err
+		}
+		course.GradePoints = &points
+		grades = append(grades, grade)
+	}
+
+	gpa, err := calculateGPA(_self.GradeScale, grades)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := make([]*repositories.TermGPAEntity, 0, len(gpa.Terms))
+	for _, term := range gpa.Terms {
+		terms = append(terms, &repositories.TermGPAEntity{
+			Term:    term.Term,
+			GPA:     term.GPA,
+			Courses: term.Courses,
+		})
+	}
+
+	return &repositories.TranscriptEntity{
+		Student:       student,
+		Courses:       courses,
+		Terms:         terms,
+		CumulativeGPA: gpa.Cumulative,
+	}, nil
+}
+
+// SetCreditOverride approves an overload, the student may then carry up to override.MaxCredits per term
+func (_self Student) SetCreditOverride(studentID string, override *models.CreditOverrideModel) error {
+	return _self.StudentRepositories.SetCreditOverride(studentID, override)
+}
+
+func (_self Student) DeleteCreditOverride(studentID string) error {
+	return _self.StudentRepositories.DeleteCreditOverride(studentID)
+}
+
+// GetStudentSchedule lists the sessions of the courses the student is actively enrolled in
+func (_self Student) GetStudentSchedule(studentID string) ([]*models.ScheduleEventModel, error) {
+	courses, err := _self.StudentRepositories.GetStudentCourses(studentID, &models.StudentCourseFilterModel{
+		Status: models.EnrollmentStatusActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scheduleEvents(_self.CourseRepositories, courses)
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"database/sql"
 	"errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -23,6 +24,11 @@ func (m *MockStudentRepository) GetStudentByID(id string) (*repositories.Student
 	return returnArgs.Get(0).(*repositories.StudentEntity), returnArgs.Error(1)
 }
 
+func (m *MockStudentRepository) GetStudentByCode(code string) (*repositories.StudentEntity, error) {
+	returnArgs := m.Called(code)
+	return returnArgs.Get(0).(*repositories.StudentEntity), returnArgs.Error(1)
+}
+
 func (m *MockStudentRepository) DeleteStudent(id string) error {
 	returnArgs := m.Called(id)
 	return returnArgs.Error(0)
@@ -38,6 +44,56 @@ func (m *MockStudentRepository) RegisterCourse(registerCourseModel *models.Regis
 	return returnArgs.Get(0).(*models.RegisterCourseModel), returnArgs.Error(1)
 }
 
+func (m *MockStudentRepository) EnrollCourse(studentID string, courseID string, options *models.EnrollmentOptionsModel, maxCreditLoad int) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(studentID, courseID, options, maxCreditLoad)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentRepository) WithdrawCourse(studentID string, courseID string, withdrawal *models.WithdrawCourseModel, maxCreditLoad int) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(studentID, courseID, withdrawal, maxCreditLoad)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentRepository) GetStudentCourses(studentID string, filter *models.StudentCourseFilterModel) ([]*models.CourseModel, error) {
+	returnArgs := m.Called(studentID, filter)
+	return returnArgs.Get(0).([]*models.CourseModel), returnArgs.Error(1)
+}
+
+func (m *MockStudentRepository) RecordGrade(studentID string, courseID string, grade *models.RecordGradeModel) (*repositories.EnrollmentEntity, error) {
+	returnArgs := m.Called(studentID, courseID, grade)
+	return returnArgs.Get(0).(*repositories.EnrollmentEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentRepository) GetStudentGrades(studentID string) ([]*models.GradeModel, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).([]*models.GradeModel), returnArgs.Error(1)
+}
+
+func (m *MockStudentRepository) GetTranscriptCourses(studentID string) ([]*repositories.TranscriptCourseEntity, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).([]*repositories.TranscriptCourseEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentRepository) GetCourseHistory(studentID string) ([]*repositories.CourseHistoryEntity, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).([]*repositories.CourseHistoryEntity), returnArgs.Error(1)
+}
+
+func (m *MockStudentRepository) SetCreditOverride(studentID string, override *models.CreditOverrideModel) error {
+	returnArgs := m.Called(studentID, override)
+	return returnArgs.Error(0)
+}
+
+func (m *MockStudentRepository) DeleteCreditOverride(studentID string) error {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Error(0)
+}
+
+func (m *MockStudentRepository) GetCreditOverride(studentID string) (*models.CreditOverrideModel, error) {
+	returnArgs := m.Called(studentID)
+	return returnArgs.Get(0).(*models.CreditOverrideModel), returnArgs.Error(1)
+}
+
 type MockUtil struct {
 	mock.Mock
 }
@@ -299,6 +355,8 @@ func Test_UpdateStudent(t *testing.T) {
 }
 
 func Test_RegisterCourse(t *testing.T) {
+	closedAt := "2020-11-01T00:00:00Z"
+
 	testCases := []struct {
 		name                string
 		input               *models.RegisterCourseModel
@@ -318,6 +376,25 @@ func Test_RegisterCourse(t *testing.T) {
 			expectedError:       errors.New("generate id fail"),
 			mockGenerateIDError: errors.New("generate id fail"),
 		},
+		{
+			name: "registration closed",
+			input: &models.RegisterCourseModel{
+				Student: &models.StudentModel{},
+				Course: &models.CourseModel{
+					StartTime:            "2020-11-02T00:00:00Z",
+					EndTime:              "2020-11-03T00:00:00Z",
+					RegistrationClosesAt: &closedAt,
+					Term: &models.TermModel{
+						ID: 1,
+					},
+				},
+			},
+			expectedValue: nil,
+			expectedError: &models.RegistrationWindowError{
+				Code:     models.RegistrationClosed,
+				ClosesAt: &closedAt,
+			},
+		},
 		{
 			name: "register course fail",
 			input: &models.RegisterCourseModel{
@@ -385,8 +462,12 @@ func Test_RegisterCourse(t *testing.T) {
 			mockUtil := new(MockUtil)
 			mockUtil.On("GenerateID", "[A-Z0-9]{6}", 6).Return("123456", testCase.mockGenerateIDError)
 
+			mockTermRepo := new(MockTermRepository)
+			mockTermRepo.On("GetTermByID", "1").Return(fall2020, nil)
+
 			studentService := Student{
 				StudentRepositories: mockRepo,
+				TermRepositories:    mockTermRepo,
 				Utils:               mockUtil,
 			}
 
@@ -401,3 +482,871 @@ func Test_RegisterCourse(t *testing.T) {
 		})
 	}
 }
+
+func Test_EnrollCourse(t *testing.T) {
+	math := &models.CourseModel{
+		ID:        1,
+		Name:      "Math",
+		StartTime: "2020-11-02T00:00:00Z",
+		EndTime:   "2020-11-03T00:00:00Z",
+	}
+	physics := &models.CourseModel{
+		ID:        2,
+		Name:      "Physics",
+		StartTime: "2020-11-02T12:00:00Z",
+		EndTime:   "2020-11-04T00:00:00Z",
+	}
+	chemistry := &models.CourseModel{
+		ID:        3,
+		Name:      "Chemistry",
+		StartTime: "2020-11-03T00:00:00Z",
+		EndTime:   "2020-11-04T00:00:00Z",
+	}
+	closedAt := "2020-11-01T00:00:00Z"
+	closedChemistry := &models.CourseModel{
+		ID:                   3,
+		Name:                 "Chemistry",
+		StartTime:            "2020-11-03T00:00:00Z",
+		EndTime:              "2020-11-04T00:00:00Z",
+		RegistrationClosesAt: &closedAt,
+	}
+	fall := &models.TermModel{ID: 1, Name: "Fall 2020"}
+	biology := &models.CourseModel{
+		ID:        4,
+		Name:      "Biology",
+		StartTime: "2020-11-05T00:00:00Z",
+		EndTime:   "2020-11-06T00:00:00Z",
+		Credits:   4,
+		Term:      fall,
+	}
+
+	testCases := []struct {
+		name                    string
+		inputID                 string
+		inputCourseID           string
+		inputOptions            *models.EnrollmentOptionsModel
+		expectedValue           *repositories.EnrollmentEntity
+		expectedError           error
+		mockOverride            bool
+		mockPrerequisites       []*models.CourseModel
+		mockCompletedCourses    []*models.CourseModel
+		mockCourseResult        *models.CourseModel
+		mockCourseError         error
+		mockStudentCourses      []*models.CourseModel
+		mockStudentCoursesError error
+		mockRepoResult          *repositories.EnrollmentEntity
+		mockRepoError           error
+	}{
+		{
+			name:             "course not found",
+			inputID:          "1",
+			inputCourseID:    "4",
+			inputOptions:     &models.EnrollmentOptionsModel{},
+			expectedValue:    nil,
+			expectedError:    models.ErrCourseNotFound,
+			mockCourseResult: nil,
+			mockCourseError:  sql.ErrNoRows,
+		},
+		{
+			name:                    "student not found",
+			inputID:                 "3",
+			inputCourseID:           "2",
+			inputOptions:            &models.EnrollmentOptionsModel{},
+			expectedValue:           nil,
+			expectedError:           models.ErrStudentNotFound,
+			mockCourseResult:        physics,
+			mockStudentCourses:      nil,
+			mockStudentCoursesError: models.ErrStudentNotFound,
+		},
+		{
+			name:             "registration closed",
+			inputID:          "1",
+			inputCourseID:    "3",
+			inputOptions:     &models.EnrollmentOptionsModel{},
+			expectedValue:    nil,
+			expectedError:    &models.RegistrationWindowError{Code: models.RegistrationClosed, ClosesAt: &closedAt},
+			mockOverride:     false,
+			mockCourseResult: closedChemistry,
+		},
+		{
+			name:               "registration closed with late add override",
+			inputID:            "1",
+			inputCourseID:      "3",
+			inputOptions:       &models.EnrollmentOptionsModel{},
+			mockOverride:       true,
+			mockCourseResult:   closedChemistry,
+			mockStudentCourses: []*models.CourseModel{},
+			expectedValue: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  3,
+			},
+			expectedError: nil,
+			mockRepoResult: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  3,
+			},
+			mockRepoError: nil,
+		},
+		{
+			name:                 "missing prerequisites",
+			inputID:              "1",
+			inputCourseID:        "3",
+			inputOptions:         &models.EnrollmentOptionsModel{},
+			expectedValue:        nil,
+			expectedError:        &models.MissingPrerequisitesError{Courses: []*models.CourseModel{physics}},
+			mockPrerequisites:    []*models.CourseModel{math, physics},
+			mockCompletedCourses: []*models.CourseModel{math},
+			mockCourseResult:     chemistry,
+		},
+		{
+			name:                 "prerequisites completed",
+			inputID:              "1",
+			inputCourseID:        "3",
+			inputOptions:         &models.EnrollmentOptionsModel{},
+			mockPrerequisites:    []*models.CourseModel{math},
+			mockCompletedCourses: []*models.CourseModel{math},
+			mockCourseResult:     chemistry,
+			mockStudentCourses:   []*models.CourseModel{},
+			expectedValue: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  3,
+			},
+			expectedError: nil,
+			mockRepoResult: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  3,
+			},
+			mockRepoError: nil,
+		},
+		{
+			name:               "schedule conflict",
+			inputID:            "1",
+			inputCourseID:      "2",
+			inputOptions:       &models.EnrollmentOptionsModel{},
+			expectedValue:      nil,
+			expectedError:      &models.ScheduleConflictError{Courses: []*models.CourseModel{math}},
+			mockCourseResult:   physics,
+			mockStudentCourses: []*models.CourseModel{math},
+		},
+		{
+			name:          "schedule conflict overridden",
+			inputID:       "1",
+			inputCourseID: "2",
+			inputOptions: &models.EnrollmentOptionsModel{
+				OverrideScheduleConflict: true,
+			},
+			mockCourseResult: physics,
+			expectedValue: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  2,
+			},
+			expectedError: nil,
+			mockRepoResult: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  2,
+			},
+			mockRepoError: nil,
+		},
+		{
+			name:             "credit limit exceeded",
+			inputID:          "1",
+			inputCourseID:    "4",
+			inputOptions:     &models.EnrollmentOptionsModel{},
+			expectedValue:    nil,
+			expectedError:    &models.CreditLimitExceededError{Term: "Fall 2020", CurrentCredits: 16, CourseCredits: 4, Limit: 18},
+			mockCourseResult: biology,
+			mockRepoResult:   nil,
+			mockRepoError:    &models.CreditLimitExceededError{Term: "Fall 2020", CurrentCredits: 16, CourseCredits: 4, Limit: 18},
+		},
+		{
+			name:               "enroll course fail",
+			inputID:            "1",
+			inputCourseID:      "1",
+			inputOptions:       &models.EnrollmentOptionsModel{},
+			expectedValue:      nil,
+			expectedError:      models.ErrAlreadyEnrolled,
+			mockCourseResult:   math,
+			mockStudentCourses: []*models.CourseModel{math},
+			mockRepoResult:     nil,
+			mockRepoError:      models.ErrAlreadyEnrolled,
+		},
+		{
+			name:               "enroll course successfully",
+			inputID:            "1",
+			inputCourseID:      "3",
+			inputOptions:       &models.EnrollmentOptionsModel{},
+			mockCourseResult:   chemistry,
+			mockStudentCourses: []*models.CourseModel{math},
+			expectedValue: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  3,
+			},
+			expectedError: nil,
+			mockRepoResult: &repositories.EnrollmentEntity{
+				ID:        2,
+				StudentID: 1,
+				CourseID:  3,
+			},
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockStudentRepository)
+			mockRepo.On("GetStudentCourses", testCase.inputID, &models.StudentCourseFilterModel{Status: "active"}).Return(testCase.mockStudentCourses, testCase.mockStudentCoursesError)
+			mockRepo.On("GetStudentCourses", testCase.inputID, &models.StudentCourseFilterModel{Status: "completed"}).Return(testCase.mockCompletedCourses, nil)
+			mockRepo.On("EnrollCourse", testCase.inputID, testCase.inputCourseID, testCase.inputOptions, models.DefaultMaxCreditLoad).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			mockCourseRepo := new(MocCourseRepository)
+			mockCourseRepo.On("GetPrerequisites", testCase.inputCourseID).Return(testCase.mockPrerequisites, nil)
+			mockCourseRepo.On("GetCourseByID", testCase.inputCourseID).Return(testCase.mockCourseResult, testCase.mockCourseError)
+			mockCourseRepo.On("GetCourseSessions", mock.Anything).Return([]*repositories.SessionEntity{}, nil)
+			mockCourseRepo.On("HasRegistrationOverride", testCase.inputCourseID, testCase.inputID).Return(testCase.mockOverride, nil)
+
+			studentService := Student{
+				StudentRepositories: mockRepo,
+				CourseRepositories:  mockCourseRepo,
+				MaxCreditLoad:       models.DefaultMaxCreditLoad,
+			}
+
+			result, err := studentService.EnrollCourse(testCase.inputID, testCase.inputCourseID, testCase.inputOptions)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_WithdrawCourse(t *testing.T) {
+	testCases := []struct {
+		name            string
+		inputID         string
+		inputCourseID   string
+		inputWithdrawal *models.WithdrawCourseModel
+		expectedValue   *repositories.EnrollmentEntity
+		expectedError   error
+		mockRepoResult  *repositories.EnrollmentEntity
+		mockRepoError   error
+	}{
+		{
+			name:          "withdraw course fail",
+			inputID:       "2",
+			inputCourseID: "1",
+			inputWithdrawal: &models.WithdrawCourseModel{
+				Reason:        "Schedule change",
+				EffectiveDate: "2020-11-05T00:00:00Z",
+				PerformedBy:   "registrar",
+			},
+			expectedValue:  nil,
+			expectedError:  models.ErrNotEnrolled,
+			mockRepoResult: nil,
+			mockRepoError:  models.ErrNotEnrolled,
+		},
+		{
+			name:          "withdraw course without effective date",
+			inputID:       "1",
+			inputCourseID: "1",
+			inputWithdrawal: &models.WithdrawCourseModel{
+				Reason:      "Schedule change",
+				PerformedBy: "registrar",
+			},
+			expectedValue: &repositories.EnrollmentEntity{
+				ID:        1,
+				StudentID: 1,
+				CourseID:  1,
+				Status:    "withdrawn",
+			},
+			expectedError: nil,
+			mockRepoResult: &repositories.EnrollmentEntity{
+				ID:        1,
+				StudentID: 1,
+				CourseID:  1,
+				Status:    "withdrawn",
+			},
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockStudentRepository)
+			mockRepo.On("WithdrawCourse", testCase.inputID, testCase.inputCourseID, mock.MatchedBy(func(withdrawal *models.WithdrawCourseModel) bool {
+				return withdrawal.EffectiveDate != ""
+			}), models.DefaultMaxCreditLoad).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			studentService := Student{
+				StudentRepositories: mockRepo,
+				MaxCreditLoad:       models.DefaultMaxCreditLoad,
+			}
+
+			result, err := studentService.WithdrawCourse(testCase.inputID, testCase.inputCourseID, testCase.inputWithdrawal)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetStudentCourses(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputID        string
+		inputFilter    *models.StudentCourseFilterModel
+		expectedValue  []*models.CourseModel
+		expectedError  error
+		mockRepoFilter *models.StudentCourseFilterModel
+		mockRepoResult []*models.CourseModel
+		mockRepoError  error
+	}{
+		{
+			name:           "get student courses fail",
+			inputID:        "3",
+			inputFilter:    &models.StudentCourseFilterModel{Status: "active"},
+			expectedValue:  nil,
+			expectedError:  models.ErrStudentNotFound,
+			mockRepoResult: nil,
+			mockRepoError:  models.ErrStudentNotFound,
+		},
+		{
+			name:    "status defaults to active",
+			inputID: "1",
+			inputFilter: &models.StudentCourseFilterModel{
+				From: "11/1/2020",
+				To:   "11/30/2020",
+			},
+			expectedValue: []*models.CourseModel{
+				{ID: 1, Name: "Math", Teacher: &models.TeacherModel{ID: 1}},
+			},
+			expectedError: nil,
+			mockRepoFilter: &models.StudentCourseFilterModel{
+				From:   "11/1/2020",
+				To:     "11/30/2020",
+				Status: models.EnrollmentStatusActive,
+			},
+			mockRepoResult: []*models.CourseModel{
+				{ID: 1, Name: "Math", Teacher: &models.TeacherModel{ID: 1}},
+			},
+			mockRepoError: nil,
+		},
+		{
+			name:    "get student courses successfully",
+			inputID: "1",
+			inputFilter: &models.StudentCourseFilterModel{
+				Status: "active",
+			},
+			expectedValue: []*models.CourseModel{
+				{ID: 1, Name: "Math", Teacher: &models.TeacherModel{ID: 1}},
+			},
+			expectedError: nil,
+			mockRepoResult: []*models.CourseModel{
+				{ID: 1, Name: "Math", Teacher: &models.TeacherModel{ID: 1}},
+			},
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepoFilter := testCase.inputFilter
+			if testCase.mockRepoFilter != nil {
+				mockRepoFilter = testCase.mockRepoFilter
+			}
+			mockRepo := new(MockStudentRepository)
+			mockRepo.On("GetStudentCourses", testCase.inputID, mockRepoFilter).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			studentService := Student{
+				StudentRepositories: mockRepo,
+			}
+
+			result, err := studentService.GetStudentCourses(testCase.inputID, testCase.inputFilter)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_RecordGrade(t *testing.T) {
+	a := "A"
+	pass := "P"
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		inputCourseID  string
+		inputGrade     *models.RecordGradeModel
+		expectedValue  *repositories.EnrollmentEntity
+		expectedError  error
+		mockRepoResult *repositories.EnrollmentEntity
+		mockRepoError  error
+	}{
+		{
+			name:          "letter not on scale",
+			inputID:       "1",
+			inputCourseID: "1",
+			inputGrade:    &models.RecordGradeModel{Letter: &pass},
+			expectedValue: nil,
+			expectedError: models.ErrInvalidGrade,
+		},
+		{
+			name:           "student not enrolled",
+			inputID:        "1",
+			inputCourseID:  "2",
+			inputGrade:     &models.RecordGradeModel{Letter: &a},
+			expectedValue:  nil,
+			expectedError:  models.ErrNotEnrolled,
+			mockRepoResult: nil,
+			mockRepoError:  models.ErrNotEnrolled,
+		},
+		{
+			name:          "record grade successfully",
+			inputID:       "1",
+			inputCourseID: "1",
+			inputGrade:    &models.RecordGradeModel{Letter: &a},
+			expectedValue: &repositories.EnrollmentEntity{
+				ID:          1,
+				StudentID:   1,
+				CourseID:    1,
+				Status:      "completed",
+				GradeLetter: &a,
+			},
+			expectedError: nil,
+			mockRepoResult: &repositories.EnrollmentEntity{
+				ID:          1,
+				StudentID:   1,
+				CourseID:    1,
+				Status:      "completed",
+				GradeLetter: &a,
+			},
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockStudentRepository)
+			mockRepo.On("RecordGrade", testCase.inputID, testCase.inputCourseID, testCase.inputGrade).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			studentService := Student{
+				StudentRepositories: mockRepo,
+				GradeScale:          models.DefaultGradeScale,
+			}
+
+			result, err := studentService.RecordGrade(testCase.inputID, testCase.inputCourseID, testCase.inputGrade)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetStudentGPA(t *testing.T) {
+	a := "A"
+	b := "B"
+	cumulative := 3.75
+
+	testCases := []struct {
+		name           string
+		input          string
+		expectedValue  *models.GPAModel
+		expectedError  error
+		mockRepoResult []*models.GradeModel
+		mockRepoError  error
+	}{
+		{
+			name:           "student not found",
+			input:          "3",
+			expectedValue:  nil,
+			expectedError:  models.ErrStudentNotFound,
+			mockRepoResult: nil,
+			mockRepoError:  models.ErrStudentNotFound,
+		},
+		{
+			name:  "get student gpa successfully",
+			input: "1",
+			expectedValue: &models.GPAModel{
+				Cumulative: &cumulative,
+				Courses:    2,
+				Terms: []*models.TermGPAModel{
+					{Term: "Fall 2020", GPA: 3.75, Courses: 2},
+				},
+			},
+			expectedError: nil,
+			mockRepoResult: []*models.GradeModel{
+				{CourseID: 1, CourseName: "Math", Credits: 3, Term: "Fall 2020", StartTime: "2020-11-02T00:00:00Z", Letter: &a},
+				{CourseID: 2, CourseName: "Physics", Credits: 1, Term: "Fall 2020", StartTime: "2020-11-02T12:00:00Z", Letter: &b},
+			},
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockStudentRepository)
+			mockRepo.On("GetStudentGrades", testCase.input).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			studentService := Student{
+				StudentRepositories: mockRepo,
+				GradeScale:          models.DefaultGradeScale,
+			}
+
+			result, err := studentService.GetStudentGPA(testCase.input)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetStudentTranscript(t *testing.T) {
+	a := "A"
+	score := 85.0
+	cumulative := 3.5
+	aPoints := 4.0
+	bPoints := 3.0
+
+	student := &repositories.StudentEntity{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "Le"}
+
+	testCases := []struct {
+		name              string
+		input             string
+		expectedValue     *repositories.TranscriptEntity
+		expectedError     error
+		mockStudentResult *repositories.StudentEntity
+		mockStudentError  error
+		mockRepoResult    []*repositories.TranscriptCourseEntity
+		mockRepoError     error
+	}{
+		{
+			name:              "student not found",
+			input:             "3",
+			expectedValue:     nil,
+			expectedError:     models.ErrStudentNotFound,
+			mockStudentResult: nil,
+			mockStudentError:  sql.ErrNoRows,
+			mockRepoResult:    nil,
+			mockRepoError:     nil,
+		},
+		{
+			name:  "get student transcript successfully",
+			input: "1",
+			expectedValue: &repositories.TranscriptEntity{
+				Student: student,
+				Courses: []*repositories.TranscriptCourseEntity{
+					{EnrollmentID: 1, CourseID: 1, CourseName: "Math", Credits: 4, Term: "Fall 2020", StartTime: "2020-11-02T00:00:00Z", Status: models.EnrollmentStatusCompleted, GradeLetter: &a, GradePoints: &aPoints},
+					{EnrollmentID: 2, CourseID: 2, CourseName: "Physics", Credits: 4, Term: "Fall 2020", StartTime: "2020-11-02T12:00:00Z", Status: models.EnrollmentStatusCompleted, GradeScore: &score, GradePoints: &bPoints},
+					{EnrollmentID: 3, CourseID: 3, CourseName: "Chemistry", Credits: 3, Term: "Spring 2021", StartTime: "2021-02-01T00:00:00Z", Status: models.EnrollmentStatusActive},
+				},
+				Terms: []*repositories.TermGPAEntity{
+					{Term: "Fall 2020", GPA: 3.5, Courses: 2},
+				},
+				CumulativeGPA: &cumulative,
+			},
+			expectedError:     nil,
+			mockStudentResult: student,
+			mockStudentError:  nil,
+			mockRepoResult: []*repositories.TranscriptCourseEntity{
+				{EnrollmentID: 1, CourseID: 1, CourseName: "Math", Credits: 4, Term: "Fall 2020", StartTime: "2020-11-02T00:00:00Z", Status: models.EnrollmentStatusCompleted, GradeLetter: &a},
+				{EnrollmentID: 2, CourseID: 2, CourseName: "Physics", Credits: 4, Term: "Fall 2020", StartTime: "2020-11-02T12:00:00Z", Status: models.EnrollmentStatusCompleted, GradeScore: &score},
+				{EnrollmentID: 3, CourseID: 3, CourseName: "Chemistry", Credits: 3, Term: "Spring 2021", StartTime: "2021-02-01T00:00:00Z", Status: models.EnrollmentStatusActive},
+			},
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockStudentRepository)
+			mockRepo.On("GetStudentByID", testCase.input).Return(testCase.mockStudentResult, testCase.mockStudentError)
+			mockRepo.On("GetTranscriptCourses", testCase.input).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			studentService := Student{
+				StudentRepositories: mockRepo,
+				GradeScale:          models.DefaultGradeScale,
+			}
+
+			result, err := studentService.GetStudentTranscript(testCase.input)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetStudentSchedule(t *testing.T) {
+	teacher := &models.TeacherModel{ID: 1, FirstName: "Hoa", LastName: "Tran"}
+	mathCourse := &models.CourseModel{ID: 1, Name: "Math", Teacher: teacher, StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-10T00:00:00Z"}
+	physics := &models.CourseModel{ID: 2, Name: "Physics", Teacher: teacher, StartTime: "2020-11-03T00:00:00Z", EndTime: "2020-11-04T00:00:00Z"}
+	mathSessions := []*repositories.SessionEntity{
+		{ID: 1, CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T14:00:00Z", EndTime: "2020-11-02T15:30:00Z"},
+		{ID: 2, CourseID: 1, Date: "2020-11-09", StartTime: "2020-11-09T14:00:00Z", EndTime: "2020-11-09T15:30:00Z"},
+	}
+
+	testCases := []struct {
+		name           string
+		input          string
+		expectedValue  []*models.ScheduleEventModel
+		expectedError  error
+		mockCourses    []*models.CourseModel
+		mockRepoError  error
+		mockSessions   map[string][]*repositories.SessionEntity
+		mockSessionErr error
+	}{
+		{
+			name:          "student not found",
+			input:         "3",
+			expectedValue: nil,
+			expectedError: models.ErrStudentNotFound,
+			mockCourses:   nil,
+			mockRepoError: models.ErrStudentNotFound,
+		},
+		{
+			name:           "get sessions fail",
+			input:          "1",
+			expectedValue:  nil,
+			expectedError:  errors.New("get sessions fail"),
+			mockCourses:    []*models.CourseModel{mathCourse},
+			mockSessions:   map[string][]*repositories.SessionEntity{"1": nil},
+			mockSessionErr: errors.New("get sessions fail"),
+		},
+		{
+			name:  "get student schedule successfully",
+			input: "1",
+			expectedValue: []*models.ScheduleEventModel{
+				{UID: "course-1-20201102T140000Z@student-rest", CourseID: 1, CourseName: "Math", Teacher: "Hoa Tran", StartTime: "2020-11-02T14:00:00Z", EndTime: "2020-11-02T15:30:00Z"},
+				{UID: "course-2@student-rest", CourseID: 2, CourseName: "Physics", Teacher: "Hoa Tran", StartTime: "2020-11-03T00:00:00Z", EndTime: "2020-11-04T00:00:00Z"},
+				{UID: "course-1-20201109T140000Z@student-rest", CourseID: 1, CourseName: "Math", Teacher: "Hoa Tran", StartTime: "2020-11-09T14:00:00Z", EndTime: "2020-11-09T15:30:00Z"},
+			},
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{mathCourse, physics},
+			mockSessions: map[string][]*repositories.SessionEntity{
+				"1": mathSessions,
+				"2": {},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockStudentRepository)
+			mockRepo.On("GetStudentCourses", testCase.input, &models.StudentCourseFilterModel{Status: "active"}).Return(testCase.mockCourses, testCase.mockRepoError)
+
+			mockCourseRepo := new(MocCourseRepository)
+			for courseID, sessions := range testCase.mockSessions {
+				mockCourseRepo.On("GetCourseSessions", courseID).Return(sessions, testCase.mockSessionErr)
+			}
+
+			studentService := Student{
+				StudentRepositories: mockRepo,
+				CourseRepositories:  mockCourseRepo,
+			}
+
+			result, err := studentService.GetStudentSchedule(testCase.input)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetStudentAudit(t *testing.T) {
+	a, b, c, d, f := "A", "B", "C", "D", "F"
+	electiveCredits := 6
+	programID := 1
+
+	student := &repositories.StudentEntity{ID: 1, StudentID: "123456", FirstName: "Anh", LastName: "Le", ProgramID: &programID}
+	program := &repositories.ProgramEntity{ID: 1, DepartmentID: 1, Code: "BSC-PHYS", Name: "Physics", Degree: "BSc"}
+
+	core := &repositories.ProgramRequirementEntity{ID: 1, ProgramID: 1, Name: "Core", Kind: models.RequirementKindCourses, MinGrade: &c,
+		Courses: []*repositories.RequirementCourseEntity{{ID: 1, Name: "Math", Credits: 3}, {ID: 2, Name: "Physics", Credits: 3}}}
+	electives := &repositories.ProgramRequirementEntity{ID: 2, ProgramID: 1, Name: "Electives", Kind: models.RequirementKindCredits, Credits: &electiveCredits,
+		Courses: []*repositories.RequirementCourseEntity{{ID: 3, Name: "Art", Credits: 3}, {ID: 4, Name: "Music", Credits: 3}, {ID: 5, Name: "History", Credits: 3}}}
+	lab := &repositories.ProgramRequirementEntity{ID: 3, ProgramID: 1, Name: "Lab", Kind: models.RequirementKindCourses,
+		Courses: []*repositories.RequirementCourseEntity{{ID: 6, Name: "Chemistry", Credits: 2}}}
+
+	testCases := []struct {
+		name                   string
+		input                  string
+		expectedValue          *repositories.AuditEntity
+		expectedError          error
+		mockStudentResult      *repositories.StudentEntity
+		mockStudentError       error
+		mockRequirementsResult []*repositories.ProgramRequirementEntity
+		mockHistoryResult      []*repositories.CourseHistoryEntity
+	}{
+		{
+			name:              "student not found",
+			input:             "3",
+			expectedError:     models.ErrStudentNotFound,
+			mockStudentResult: nil,
+			mockStudentError:  sql.ErrNoRows,
+		},
+		{
+			name:              "student is not in a program",
+			input:             "2",
+			expectedError:     models.ErrStudentHasNoProgram,
+			mockStudentResult: &repositories.StudentEntity{ID: 2, StudentID: "654321", FirstName: "Mai", LastName: "Dao"},
+			mockStudentError:  nil,
+		},
+		{
+			name:  "audit student successfully",
+			input: "1",
+			expectedValue: &repositories.AuditEntity{
+				Student: student,
+				Program: program,
+				Satisfied: []*repositories.RequirementAuditEntity{
+					{ID: 2, Name: "Electives", Kind: models.RequirementKindCredits, Status: models.AuditStatusSatisfied,
+						RequiredCredits: &electiveCredits, EarnedCredits: 6, Courses: []*repositories.CourseAuditEntity{
+							{CourseID: 3, CourseName: "Art", Credits: 3, Status: models.AuditStatusSatisfied, GradeLetter: &a},
+							{CourseID: 4, CourseName: "Music", Credits: 3, Status: models.AuditStatusSatisfied, GradeLetter: &d},
+							{CourseID: 5, CourseName: "History", Credits: 3, Status: models.AuditStatusMissing},
+						}},
+				},
+				InProgress: []*repositories.RequirementAuditEntity{
+					{ID: 1, Name: "Core", Kind: models.RequirementKindCourses, Status: models.AuditStatusInProgress, MinGrade: &c,
+						EarnedCredits: 3, InProgressCredits: 3, Courses: []*repositories.CourseAuditEntity{
+							{CourseID: 1, CourseName: "Math", Credits: 3, Status: models.AuditStatusSatisfied, GradeLetter: &b},
+							{CourseID: 2, CourseName: "Physics", Credits: 3, Status: models.AuditStatusInProgress},
+						}},
+				},
+				Missing: []*repositories.RequirementAuditEntity{
+					{ID: 3, Name: "Lab", Kind: models.RequirementKindCourses, Status: models.AuditStatusMissing,
+						Courses: []*repositories.CourseAuditEntity{
+							{CourseID: 6, CourseName: "Chemistry", Credits: 2, Status: models.AuditStatusMissing},
+						}},
+				},
+			},
+			expectedError:          nil,
+			mockStudentResult:      student,
+			mockStudentError:       nil,
+			mockRequirementsResult: []*repositories.ProgramRequirementEntity{core, electives, lab},
+			mockHistoryResult: []*repositories.CourseHistoryEntity{
+				{CourseID: 1, CourseName: "Math", Credits: 3, Status: models.EnrollmentStatusFailed, GradeLetter: &f},
+				{CourseID: 1, CourseName: "Math", Credits: 3, Status: models.EnrollmentStatusCompleted, GradeLetter: &b},
+				{CourseID: 2, CourseName: "Physics", Credits: 3, Status: models.EnrollmentStatusActive},
+				{CourseID: 3, CourseName: "Art", Credits: 3, Status: models.EnrollmentStatusCompleted, GradeLetter: &a},
+				{CourseID: 4, CourseName: "Music", Credits: 3, Status: models.EnrollmentStatusCompleted, GradeLetter: &d},
+				{CourseID: 5, CourseName: "History", Credits: 3, Status: models.EnrollmentStatusWithdrawn},
+			},
+		},
+		{
+			name:  "course without a minimum grade needs a passing grade",
+			input: "1",
+			expectedValue: &repositories.AuditEntity{
+				Student:   student,
+				Program:   program,
+				Satisfied: []*repositories.RequirementAuditEntity{},
+				InProgress: []*repositories.RequirementAuditEntity{
+					{ID: 2, Name: "Electives", Kind: models.RequirementKindCredits, Status: models.AuditStatusInProgress,
+						RequiredCredits: &electiveCredits, EarnedCredits: 3, InProgressCredits: 3, Courses: []*repositories.CourseAuditEntity{
+							{CourseID: 3, CourseName: "Art", Credits: 3, Status: models.AuditStatusInProgress},
+							{CourseID: 4, CourseName: "Music", Credits: 3, Status: models.AuditStatusSatisfied, GradeLetter: &d},
+							{CourseID: 5, CourseName: "History", Credits: 3, Status: models.AuditStatusMissing},
+						}},
+				},
+				Missing: []*repositories.RequirementAuditEntity{
+					{ID: 3, Name: "Lab", Kind: models.RequirementKindCourses, Status: models.AuditStatusMissing,
+						Courses: []*repositories.CourseAuditEntity{
+							{CourseID: 6, CourseName: "Chemistry", Credits: 2, Status: models.AuditStatusMissing},
+						}},
+				},
+			},
+			expectedError:          nil,
+			mockStudentResult:      student,
+			mockStudentError:       nil,
+			mockRequirementsResult: []*repositories.ProgramRequirementEntity{electives, lab},
+			mockHistoryResult: []*repositories.CourseHistoryEntity{
+				{CourseID: 3, CourseName: "Art", Credits: 3, Status: models.EnrollmentStatusCompleted},
+				{CourseID: 4, CourseName: "Music", Credits: 3, Status: models.EnrollmentStatusCompleted, GradeLetter: &d},
+				{CourseID: 6, CourseName: "Chemistry", Credits: 2, Status: models.EnrollmentStatusCompleted, GradeLetter: &f},
+			},
+		},
+		{
+			name:  "grade below the minimum grade",
+			input: "1",
+			expectedValue: &repositories.AuditEntity{
+				Student:    student,
+				Program:    program,
+				Satisfied:  []*repositories.RequirementAuditEntity{},
+				InProgress: []*repositories.RequirementAuditEntity{},
+				Missing: []*repositories.RequirementAuditEntity{
+					{ID: 1, Name: "Core", Kind: models.RequirementKindCourses, Status: models.AuditStatusMissing, MinGrade: &c,
+						EarnedCredits: 3, Courses: []*repositories.CourseAuditEntity{
+							{CourseID: 1, CourseName: "Math", Credits: 3, Status: models.AuditStatusMissing},
+							{CourseID: 2, CourseName: "Physics", Credits: 3, Status: models.AuditStatusSatisfied, GradeLetter: &c},
+						}},
+				},
+			},
+			expectedError:          nil,
+			mockStudentResult:      student,
+			mockStudentError:       nil,
+			mockRequirementsResult: []*repositories.ProgramRequirementEntity{core},
+			mockHistoryResult: []*repositories.CourseHistoryEntity{
+				{CourseID: 1, CourseName: "Math", Credits: 3, Status: models.EnrollmentStatusCompleted, GradeLetter: &d},
+				{CourseID: 2, CourseName: "Physics", Credits: 3, Status: models.EnrollmentStatusCompleted, GradeLetter: &c},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockStudentRepository)
+			mockRepo.On("GetStudentByID", testCase.input).Return(testCase.mockStudentResult, testCase.mockStudentError)
+			mockRepo.On("GetCourseHistory", testCase.input).Return(testCase.mockHistoryResult, nil)
+			mockProgramRepo := new(MockProgramRepository)
+			mockProgramRepo.On("GetProgramByID", "1").Return(program, nil)
+			mockProgramRepo.On("GetProgramRequirements", "1").Return(testCase.mockRequirementsResult, nil)
+
+			studentService := Student{
+				StudentRepositories: mockRepo,
+				ProgramRepositories: mockProgramRepo,
+				GradeScale:          models.DefaultGradeScale,
+			}
+
+			result, err := studentService.GetStudentAudit(testCase.input)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
@@ -1,22 +1,33 @@
 package services
 
 import (
+	"errors"
+	"strconv"
 	"student_rest/models"
 	"student_rest/repositories"
 )
 
 type Teacher struct {
 	repositories.TeacherRepositories
+	repositories.CourseRepositories
+	DepartmentRepositories repositories.DepartmentRepositories
+	TeacherWorkload        *models.TeacherWorkloadModel
 }
 
 type TeacherServices interface {
 	CreateTeacher(teacher *models.TeacherModel) (*repositories.TeacherEntity, error)
 	GetTeacherByID(id string) (*repositories.TeacherEntity, error)
-	DeleteTeacher(id string) error
+	GetTeachers(filter *models.TeacherFilterModel) ([]*repositories.TeacherEntity, error)
+	DeleteTeacher(id string, deletion *models.TeacherDeletionModel) error
 	UpdateTeacher(id string, teacher *models.TeacherModel) error
+	GetTeacherSchedule(id string, filter *models.ScheduleFilterModel) ([]*models.ScheduleEventModel, error)
+	GetTeacherCourses(id string, filter *models.CourseFilterModel) ([]*models.CourseModel, error)
 }
 
 func (_self Teacher) CreateTeacher(teacher *models.TeacherModel) (*repositories.TeacherEntity, error) {
+	if err := checkDepartment(_self.DepartmentRepositories, teacher.DepartmentID); err != nil {
+		return nil, err
+	}
 	convertedTeacher := transformTeacherModelToTeacherEntity(teacher)
 	result, err := _self.TeacherRepositories.CreateTeacher(convertedTeacher)
 	if err != nil {
@@ -30,6 +41,8 @@ func transformTeacherModelToTeacherEntity(model *models.TeacherModel) *repositor
 		FirstName:   model.FirstName,
 		LastName:    model.LastName,
 		DateOfBirth: model.DateOfBirth,
+
+		DepartmentID: model.DepartmentID,
 	}
 }
 
@@ -38,13 +51,109 @@ func (_self Teacher) GetTeacherByID(id string) (*repositories.TeacherEntity, err
 	return result, err
 }
 
-func (_self Teacher) DeleteTeacher(id string) error {
-	err := _self.TeacherRepositories.DeleteTeacher(id)
-	return err
+func (_self Teacher) GetTeachers(filter *models.TeacherFilterModel) ([]*repositories.TeacherEntity, error) {
+	return _self.TeacherRepositories.GetTeachers(filter)
+}
+
+// DeleteTeacher removes the teacher following the deletion policy, by default a teacher who teaches or co-teaches
+// courses isn't deleted
+func (_self Teacher) DeleteTeacher(id string, deletion *models.TeacherDeletionModel) error {
+	switch deletion.Policy {
+	case models.TeacherDeletionArchive:
+		return _self.TeacherRepositories.ArchiveTeacher(id)
+	case models.TeacherDeletionReassign:
+		rules := teacherAssignmentRules{
+			CourseRepositories:  _self.CourseRepositories,
+			TeacherRepositories: _self.TeacherRepositories,
+			TeacherWorkload:     _self.TeacherWorkload,
+		}
+		courses, err := rules.teacherCourses(id)
+		if err != nil {
+			return err
+		}
+		if err = _self.checkReassignment(rules, courses, deletion.ReassignTo); err != nil {
+			return err
+		}
+		return _self.TeacherRepositories.ReassignAndDeleteTeacher(id, deletion.ReassignTo)
+	default:
+		// The repository refuses to delete a teacher who still teaches or co-teaches courses
+		return _self.TeacherRepositories.DeleteTeacher(id)
+	}
+}
+
+// checkReassignment runs the assignment checks for each course in turn, counting the courses already moved
+func (_self Teacher) checkReassignment(rules teacherAssignmentRules, courses []*models.CourseModel, reassignTo string) error {
+	reassignToID, err := strconv.Atoi(reassignTo)
+	if err != nil {
+		return models.ErrReassignTeacherNotFound
+	}
+	err = rules.checkTeacherActive(reassignToID)
+	if errors.Is(err, models.ErrTeacherNotFound) {
+		return models.ErrReassignTeacherNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	assigned, err := rules.teacherCourses(reassignTo)
+	if err != nil {
+		return err
+	}
+	taught := map[int]bool{}
+	for _, course := range assigned {
+		taught[course.ID] = true
+	}
+	for _, course := range courses {
+		// The other teacher already teaches the courses they co-teach with the teacher
+		if taught[course.ID] {
+			continue
+		}
+		id := strconv.Itoa(course.ID)
+		sessions, err := _self.CourseRepositories.GetCourseSessions(id)
+		if err != nil {
+			return err
+		}
+		pattern, err := _self.CourseRepositories.GetMeetingPattern(id)
+		if err != nil && !errors.Is(err, models.ErrMeetingPatternNotFound) {
+			return err
+		}
+
+		if err = rules.checkOverlap(course, sessions, assigned); err != nil {
+			return err
+		}
+		if err = rules.checkWorkload(course, patternWeeklyMinutes(pattern), assigned); err != nil {
+			return err
+		}
+		assigned = append(assigned, course)
+	}
+	return nil
 }
 
 func (_self Teacher) UpdateTeacher(id string, teacher *models.TeacherModel) error {
+	if err := checkDepartment(_self.DepartmentRepositories, teacher.DepartmentID); err != nil {
+		return err
+	}
 	convertedTeacher := transformTeacherModelToTeacherEntity(teacher)
 	err := _self.TeacherRepositories.UpdateTeacher(id, convertedTeacher)
 	return err
 }
+
+// GetTeacherSchedule lists the sessions of the courses the teacher teaches or co-teaches between the dates of the filter
+func (_self Teacher) GetTeacherSchedule(id string, filter *models.ScheduleFilterModel) ([]*models.ScheduleEventModel, error) {
+	courses, err := _self.CourseRepositories.GetCourses(&models.CourseFilterModel{TeacherID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := scheduleEvents(_self.CourseRepositories, courses)
+	if err != nil {
+		return nil, err
+	}
+	return eventsBetween(events, filter)
+}
+
+// GetTeacherCourses lists the courses the teacher teaches or co-teaches with their enrollment counts
+func (_self Teacher) GetTeacherCourses(id string, filter *models.CourseFilterModel) ([]*models.CourseModel, error) {
+	filter.TeacherID = id
+	return _self.CourseRepositories.GetCourses(filter)
+}
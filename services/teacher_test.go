@@ -1,6 +1,7 @@
 package services
 
 import (
+	"database/sql"
 	"errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -23,6 +24,11 @@ func (m *MockTeacherRepository) GetTeacherByID(id string) (*repositories.Teacher
 	return returnArgs.Get(0).(*repositories.TeacherEntity), returnArgs.Error(1)
 }
 
+func (m *MockTeacherRepository) GetTeachers(filter *models.TeacherFilterModel) ([]*repositories.TeacherEntity, error) {
+	returnArgs := m.Called(filter)
+	return returnArgs.Get(0).([]*repositories.TeacherEntity), returnArgs.Error(1)
+}
+
 func (m *MockTeacherRepository) DeleteTeacher(id string) error {
 	returnArgs := m.Called(id)
 	return returnArgs.Error(0)
@@ -33,7 +39,20 @@ func (m *MockTeacherRepository) UpdateTeacher(id string, teacher *repositories.T
 	return returnArgs.Error(0)
 }
 
+func (m *MockTeacherRepository) ReassignAndDeleteTeacher(id string, reassignTo string) error {
+	returnArgs := m.Called(id, reassignTo)
+	return returnArgs.Error(0)
+}
+
+func (m *MockTeacherRepository) ArchiveTeacher(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
 func Test_CreateTeacher(t *testing.T) {
+	var noDepartment *repositories.DepartmentEntity
+	unknownDepartmentID := 9
+
 	testCases := []struct {
 		name          string
 		input         *models.TeacherModel
@@ -52,6 +71,16 @@ func Test_CreateTeacher(t *testing.T) {
 			mockRepoResult: nil,
 			mockRepoError: errors.New("insert teacher fail"),
 		},
+		{
+			name: "department not found",
+			input: &models.TeacherModel{
+				FirstName:    "Mai",
+				LastName:     "Dao",
+				DepartmentID: &unknownDepartmentID,
+			},
+			expectedValue: nil,
+			expectedError: models.ErrDepartmentNotFound,
+		},
 		{
 			name: "create teacher successfully",
 			input: &models.TeacherModel{
@@ -85,9 +114,12 @@ func Test_CreateTeacher(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			mockRepo := new(MockTeacherRepository)
 			mockRepo.On("CreateTeacher", testCase.mockRepoInput).Return(testCase.mockRepoResult, testCase.mockRepoError)
+			mockDepartmentRepo := new(MockDepartmentRepository)
+			mockDepartmentRepo.On("GetDepartmentByID", "9").Return(noDepartment, models.ErrDepartmentNotFound)
 
 			teacherService := Teacher{
-				TeacherRepositories: mockRepo,
+				TeacherRepositories:    mockRepo,
+				DepartmentRepositories: mockDepartmentRepo,
 			}
 
 			result, err := teacherService.CreateTeacher(testCase.input)
@@ -164,45 +196,175 @@ func Test_GetTeacherByID(t *testing.T) {
 }
 
 func Test_DeleteTeacher(t *testing.T) {
+	fall := &models.TermModel{ID: 1, Name: "Fall 2020"}
+	math := &models.CourseModel{ID: 1, Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z", Term: fall}
+	physics := &models.CourseModel{ID: 2, Name: "Physics", StartTime: "2020-11-02T12:00:00Z", EndTime: "2020-11-02T14:00:00Z", Term: fall}
+	chemistry := &models.CourseModel{ID: 3, Name: "Chemistry", StartTime: "2020-12-01T00:00:00Z", EndTime: "2020-12-02T00:00:00Z", Term: fall}
+	archivedAt := "2020-10-01T00:00:00Z"
+
 	testCases := []struct {
-		name          string
-		input         string
-		expectedError error
-		mockRepoInput string
-		mockRepoError error
+		name               string
+		input              string
+		inputDeletion      *models.TeacherDeletionModel
+		expectedError      error
+		mockCourses        []*models.CourseModel
+		mockCoursesError   error
+		mockTarget         *repositories.TeacherEntity
+		mockTargetError    error
+		mockTargetCourses  []*models.CourseModel
+		mockRepoError      error
+		expectedRepoMethod string
 	}{
 		{
-			name:          "delete teacher fail",
+			name:               "teacher not found",
+			input:              "9",
+			inputDeletion:      &models.TeacherDeletionModel{},
+			expectedError:      models.ErrTeacherNotFound,
+			mockRepoError:      models.ErrTeacherNotFound,
+			expectedRepoMethod: "DeleteTeacher",
+		},
+		{
+			name:             "reassign from a missing teacher",
+			input:            "9",
+			inputDeletion:    &models.TeacherDeletionModel{Policy: models.TeacherDeletionReassign, ReassignTo: "2"},
+			expectedError:    models.ErrTeacherNotFound,
+			mockCoursesError: models.ErrTeacherNotFound,
+		},
+		{
+			name:               "delete teacher fail",
+			input:              "1",
+			inputDeletion:      &models.TeacherDeletionModel{},
+			expectedError:      errors.New("delete teacher fail"),
+			mockCourses:        []*models.CourseModel{},
+			mockRepoError:      errors.New("delete teacher fail"),
+			expectedRepoMethod: "DeleteTeacher",
+		},
+		{
+			name:               "teacher still has courses",
+			input:              "1",
+			inputDeletion:      &models.TeacherDeletionModel{Policy: models.TeacherDeletionRefuse},
+			expectedError:      &models.TeacherHasCoursesError{Courses: []*models.CourseModel{math}},
+			mockRepoError:      &models.TeacherHasCoursesError{Courses: []*models.CourseModel{math}},
+			expectedRepoMethod: "DeleteTeacher",
+		},
+		{
+			name:               "delete teacher successfully",
+			input:              "2",
+			inputDeletion:      &models.TeacherDeletionModel{},
+			expectedError:      nil,
+			mockCourses:        []*models.CourseModel{},
+			expectedRepoMethod: "DeleteTeacher",
+		},
+		{
+			name:               "archive teacher with courses",
+			input:              "1",
+			inputDeletion:      &models.TeacherDeletionModel{Policy: models.TeacherDeletionArchive},
+			expectedError:      nil,
+			mockCourses:        []*models.CourseModel{math},
+			expectedRepoMethod: "ArchiveTeacher",
+		},
+		{
+			name:            "reassign to a missing teacher",
+			input:           "1",
+			inputDeletion:   &models.TeacherDeletionModel{Policy: models.TeacherDeletionReassign, ReassignTo: "9"},
+			expectedError:   models.ErrReassignTeacherNotFound,
+			mockCourses:     []*models.CourseModel{math},
+			mockTargetError: sql.ErrNoRows,
+		},
+		{
+			name:          "reassign to an archived teacher",
 			input:         "1",
-			expectedError: errors.New("delete teacher fail"),
-			mockRepoInput: "1",
-			mockRepoError: errors.New("delete teacher fail"),
+			inputDeletion: &models.TeacherDeletionModel{Policy: models.TeacherDeletionReassign, ReassignTo: "2"},
+			expectedError: models.ErrTeacherArchived,
+			mockCourses:   []*models.CourseModel{math},
+			mockTarget:    &repositories.TeacherEntity{ID: 2, ArchivedAt: &archivedAt},
 		},
 		{
-			name:          "delete teacher successfully",
-			input:         "2",
-			expectedError: nil,
-			mockRepoInput: "2",
-			mockRepoError: nil,
+			name:              "reassigned course overlaps a course of the other teacher",
+			input:             "1",
+			inputDeletion:     &models.TeacherDeletionModel{Policy: models.TeacherDeletionReassign, ReassignTo: "2"},
+			expectedError:     &models.TeacherScheduleConflictError{Courses: []*models.CourseModel{physics}},
+			mockCourses:       []*models.CourseModel{math},
+			mockTarget:        &repositories.TeacherEntity{ID: 2},
+			mockTargetCourses: []*models.CourseModel{physics},
+		},
+		{
+			name:              "reassigned courses exceed the course limit together",
+			input:             "1",
+			inputDeletion:     &models.TeacherDeletionModel{Policy: models.TeacherDeletionReassign, ReassignTo: "2"},
+			expectedError:     &models.TeacherWorkloadExceededError{Code: models.TeacherCourseLimit, Term: "Fall 2020", Current: 2, Course: 1, Limit: 2},
+			mockCourses:       []*models.CourseModel{math, chemistry},
+			mockTarget:        &repositories.TeacherEntity{ID: 2},
+			mockTargetCourses: []*models.CourseModel{{ID: 4, Name: "Biology", StartTime: "2020-10-01T00:00:00Z", EndTime: "2020-10-02T00:00:00Z", Term: fall}},
+		},
+		{
+			name:               "course the other teacher co-teaches counts once",
+			input:              "1",
+			inputDeletion:      &models.TeacherDeletionModel{Policy: models.TeacherDeletionReassign, ReassignTo: "2"},
+			expectedError:      nil,
+			mockCourses:        []*models.CourseModel{math, chemistry},
+			mockTarget:         &repositories.TeacherEntity{ID: 2},
+			mockTargetCourses:  []*models.CourseModel{math},
+			expectedRepoMethod: "ReassignAndDeleteTeacher",
+		},
+		{
+			name:               "reassign courses successfully",
+			input:              "1",
+			inputDeletion:      &models.TeacherDeletionModel{Policy: models.TeacherDeletionReassign, ReassignTo: "2"},
+			expectedError:      nil,
+			mockCourses:        []*models.CourseModel{math, chemistry},
+			mockTarget:         &repositories.TeacherEntity{ID: 2},
+			mockTargetCourses:  []*models.CourseModel{},
+			expectedRepoMethod: "ReassignAndDeleteTeacher",
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			mockRepo := new(MockTeacherRepository)
-			mockRepo.On("DeleteTeacher", testCase.mockRepoInput).Return(testCase.mockRepoError)
+			mockRepo.On("DeleteTeacher", testCase.input).Return(testCase.mockRepoError)
+			mockRepo.On("ArchiveTeacher", testCase.input).Return(testCase.mockRepoError)
+			mockRepo.On("ReassignAndDeleteTeacher", testCase.input, testCase.inputDeletion.ReassignTo).Return(testCase.mockRepoError)
+			mockRepo.On("GetTeacherByID", testCase.inputDeletion.ReassignTo).Return(testCase.mockTarget, testCase.mockTargetError)
+
+			var noPattern *repositories.MeetingPatternEntity
+			mockCourseRepo := new(MocCourseRepository)
+			mockCourseRepo.On("GetCourses", &models.CourseFilterModel{TeacherID: testCase.input}).Return(testCase.mockCourses, testCase.mockCoursesError)
+			mockCourseRepo.On("GetCourses", &models.CourseFilterModel{TeacherID: testCase.inputDeletion.ReassignTo}).Return(testCase.mockTargetCourses, nil)
+			mockCourseRepo.On("GetCourseSessions", "1").Return([]*repositories.SessionEntity{
+				{Date: "2020-11-02", StartTime: "2020-11-02T12:00:00Z", EndTime: "2020-11-02T13:00:00Z"},
+			}, nil)
+			mockCourseRepo.On("GetCourseSessions", "2").Return([]*repositories.SessionEntity{
+				{Date: "2020-11-02", StartTime: "2020-11-02T12:30:00Z", EndTime: "2020-11-02T14:00:00Z"},
+			}, nil)
+			mockCourseRepo.On("GetCourseSessions", mock.Anything).Return([]*repositories.SessionEntity{}, nil)
+			mockCourseRepo.On("GetMeetingPattern", mock.Anything).Return(noPattern, models.ErrMeetingPatternNotFound)
 
 			teacherService := Teacher{
 				TeacherRepositories: mockRepo,
+				CourseRepositories:  mockCourseRepo,
+				TeacherWorkload:     &models.TeacherWorkloadModel{MaxCourses: 2},
 			}
 
-			err := teacherService.DeleteTeacher(testCase.input)
+			err := teacherService.DeleteTeacher(testCase.input, testCase.inputDeletion)
 
 			if testCase.expectedError != nil {
-				require.EqualError(t, err, testCase.expectedError.Error())
+				require.Equal(t, testCase.expectedError, err)
 			} else {
 				require.NoError(t, err)
 			}
+
+			removed := []string{}
+			for _, call := range mockRepo.Calls {
+				if call.Method != "GetTeacherByID" {
+					removed = append(removed, call.Method)
+				}
+			}
+			if testCase.expectedRepoMethod == "" {
+				require.Empty(t, removed)
+			} else {
+				require.Equal(t, []string{testCase.expectedRepoMethod}, removed)
+			}
 		})
 	}
 }
@@ -265,3 +427,146 @@ func Test_UpdateTeacher(t *testing.T) {
 	}
 }
 
+
+func Test_GetTeacherSchedule(t *testing.T) {
+	teacher := &models.TeacherModel{ID: 1, FirstName: "Hoa", LastName: "Tran"}
+	mathCourse := &models.CourseModel{ID: 1, Name: "Math", Teacher: teacher, StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-10T00:00:00Z"}
+	physics := &models.CourseModel{ID: 2, Name: "Physics", Teacher: teacher, StartTime: "2020-11-03T00:00:00Z", EndTime: "2020-11-04T00:00:00Z"}
+	mathSessions := []*repositories.SessionEntity{
+		{ID: 1, CourseID: 1, Date: "2020-11-02", StartTime: "2020-11-02T14:00:00Z", EndTime: "2020-11-02T15:30:00Z"},
+		{ID: 2, CourseID: 1, Date: "2020-11-09", StartTime: "2020-11-09T14:00:00Z", EndTime: "2020-11-09T15:30:00Z"},
+	}
+
+	firstMath := &models.ScheduleEventModel{UID: "course-1-20201102T140000Z@student-rest", CourseID: 1, CourseName: "Math", Teacher: "Hoa Tran", StartTime: "2020-11-02T14:00:00Z", EndTime: "2020-11-02T15:30:00Z"}
+	physicsCourse := &models.ScheduleEventModel{UID: "course-2@student-rest", CourseID: 2, CourseName: "Physics", Teacher: "Hoa Tran", StartTime: "2020-11-03T00:00:00Z", EndTime: "2020-11-04T00:00:00Z"}
+	secondMath := &models.ScheduleEventModel{UID: "course-1-20201109T140000Z@student-rest", CourseID: 1, CourseName: "Math", Teacher: "Hoa Tran", StartTime: "2020-11-09T14:00:00Z", EndTime: "2020-11-09T15:30:00Z"}
+
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputFilter   *models.ScheduleFilterModel
+		expectedValue []*models.ScheduleEventModel
+		expectedError error
+		mockCourses   []*models.CourseModel
+		mockRepoError error
+	}{
+		{
+			name:          "teacher not found",
+			inputID:       "3",
+			inputFilter:   &models.ScheduleFilterModel{},
+			expectedValue: nil,
+			expectedError: models.ErrTeacherNotFound,
+			mockCourses:   nil,
+			mockRepoError: models.ErrTeacherNotFound,
+		},
+		{
+			name:          "get whole schedule",
+			inputID:       "1",
+			inputFilter:   &models.ScheduleFilterModel{},
+			expectedValue: []*models.ScheduleEventModel{firstMath, physicsCourse, secondMath},
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{mathCourse, physics},
+		},
+		{
+			name:          "get schedule from a date",
+			inputID:       "1",
+			inputFilter:   &models.ScheduleFilterModel{From: "2020-11-04"},
+			expectedValue: []*models.ScheduleEventModel{secondMath},
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{mathCourse, physics},
+		},
+		{
+			name:          "get schedule between dates",
+			inputID:       "1",
+			inputFilter:   &models.ScheduleFilterModel{From: "2020-11-03", To: "2020-11-08"},
+			expectedValue: []*models.ScheduleEventModel{physicsCourse},
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{mathCourse, physics},
+		},
+		{
+			name:          "get schedule up to the last day",
+			inputID:       "1",
+			inputFilter:   &models.ScheduleFilterModel{To: "2020-11-02"},
+			expectedValue: []*models.ScheduleEventModel{firstMath},
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{mathCourse, physics},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockCourseRepo := new(MocCourseRepository)
+			mockCourseRepo.On("GetCourses", &models.CourseFilterModel{TeacherID: testCase.inputID}).Return(testCase.mockCourses, testCase.mockRepoError)
+			mockCourseRepo.On("GetCourseSessions", "1").Return(mathSessions, nil)
+			mockCourseRepo.On("GetCourseSessions", "2").Return([]*repositories.SessionEntity{}, nil)
+
+			teacherService := Teacher{
+				CourseRepositories: mockCourseRepo,
+			}
+
+			result, err := teacherService.GetTeacherSchedule(testCase.inputID, testCase.inputFilter)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
+
+func Test_GetTeacherCourses(t *testing.T) {
+	enrolled := 2
+
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputFilter   *models.CourseFilterModel
+		expectedValue []*models.CourseModel
+		expectedError error
+		mockRepoInput *models.CourseFilterModel
+		mockRepoError error
+	}{
+		{
+			name:          "teacher not found",
+			inputID:       "3",
+			inputFilter:   &models.CourseFilterModel{},
+			expectedValue: nil,
+			expectedError: models.ErrTeacherNotFound,
+			mockRepoInput: &models.CourseFilterModel{TeacherID: "3"},
+			mockRepoError: models.ErrTeacherNotFound,
+		},
+		{
+			name:        "get teacher courses successfully",
+			inputID:     "1",
+			inputFilter: &models.CourseFilterModel{TermID: "1", Status: models.CourseStatusActive},
+			expectedValue: []*models.CourseModel{
+				{ID: 1, Name: "Math", Enrolled: &enrolled},
+			},
+			expectedError: nil,
+			mockRepoInput: &models.CourseFilterModel{TermID: "1", TeacherID: "1", Status: models.CourseStatusActive},
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockCourseRepo := new(MocCourseRepository)
+			mockCourseRepo.On("GetCourses", testCase.mockRepoInput).Return(testCase.expectedValue, testCase.mockRepoError)
+
+			teacherService := Teacher{
+				CourseRepositories: mockCourseRepo,
+			}
+
+			result, err := teacherService.GetTeacherCourses(testCase.inputID, testCase.inputFilter)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedValue, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+	"time"
+)
+
+type Term struct {
+	repositories.TermRepositories
+}
+
+type TermServices interface {
+	CreateTerm(term *models.TermModel) (*repositories.TermEntity, error)
+	GetTerms() ([]*repositories.TermEntity, error)
+	GetTermByID(id string) (*repositories.TermEntity, error)
+	UpdateTerm(id string, term *models.TermModel) error
+	DeleteTerm(id string) error
+}
+
+func (_self Term) CreateTerm(term *models.TermModel) (*repositories.TermEntity, error) {
+	convertedTerm := transformTermModelToTermEntity(term)
+	return _self.TermRepositories.CreateTerm(convertedTerm)
+}
+
+func transformTermModelToTermEntity(model *models.TermModel) *repositories.TermEntity {
+	return &repositories.TermEntity{
+		Name:      model.Name,
+		StartDate: model.StartDate,
+		EndDate:   model.EndDate,
+		Status:    model.Status,
+	}
+}
+
+func (_self Term) GetTerms() ([]*repositories.TermEntity, error) {
+	return _self.TermRepositories.GetTerms()
+}
+
+func (_self Term) GetTermByID(id string) (*repositories.TermEntity, error) {
+	return _self.TermRepositories.GetTermByID(id)
+}
+
+func (_self Term) UpdateTerm(id string, term *models.TermModel) error {
+	convertedTerm := transformTermModelToTermEntity(term)
+	return _self.TermRepositories.UpdateTerm(id, convertedTerm)
+}
+
+func (_self Term) DeleteTerm(id string) error {
+	return _self.TermRepositories.DeleteTerm(id)
+}
+
+// checkCourseInTerm loads the term of the course into it and returns a CourseOutsideTermError
+// when the course dates don't fall inside the term. The term runs until the end of its end date.
+func checkCourseInTerm(termRepositories repositories.TermRepositories, course *models.CourseModel) error {
+	term, err := termRepositories.GetTermByID(strconv.Itoa(course.Term.ID))
+	if err != nil {
+		return err
+	}
+	course.Term = &models.TermModel{
+		ID:        term.ID,
+		Name:      term.Name,
+		StartDate: term.StartDate,
+		EndDate:   term.EndDate,
+		Status:    term.Status,
+	}
+
+	termStart, err := time.Parse(time.RFC3339, term.StartDate)
+	if err != nil {
+		return err
+	}
+	termEnd, err := time.Parse(time.RFC3339, term.EndDate)
+	if err != nil {
+		return err
+	}
+	start, err := time.Parse(time.RFC3339, course.StartTime)
+	if err != nil {
+		return err
+	}
+	end, err := time.Parse(time.RFC3339, course.EndTime)
+	if err != nil {
+		return err
+	}
+
+	if start.Before(termStart) || end.After(termEnd.AddDate(0, 0, 1)) {
+		return &models.CourseOutsideTermError{Term: course.Term}
+	}
+	return nil
+}
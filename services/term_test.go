@@ -0,0 +1,154 @@
+package services
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+type MockTermRepository struct {
+	mock.Mock
+}
+
+func (m *MockTermRepository) CreateTerm(term *repositories.TermEntity) (*repositories.TermEntity, error) {
+	returnArgs := m.Called(term)
+	return returnArgs.Get(0).(*repositories.TermEntity), returnArgs.Error(1)
+}
+
+func (m *MockTermRepository) GetTerms() ([]*repositories.TermEntity, error) {
+	returnArgs := m.Called()
+	return returnArgs.Get(0).([]*repositories.TermEntity), returnArgs.Error(1)
+}
+
+func (m *MockTermRepository) GetTermByID(id string) (*repositories.TermEntity, error) {
+	returnArgs := m.Called(id)
+	return returnArgs.Get(0).(*repositories.TermEntity), returnArgs.Error(1)
+}
+
+func (m *MockTermRepository) UpdateTerm(id string, term *repositories.TermEntity) error {
+	returnArgs := m.Called(id, term)
+	return returnArgs.Error(0)
+}
+
+func (m *MockTermRepository) DeleteTerm(id string) error {
+	returnArgs := m.Called(id)
+	return returnArgs.Error(0)
+}
+
+var fall2020 = &repositories.TermEntity{
+	ID:        1,
+	Name:      "Fall 2020",
+	StartDate: "2020-08-15T00:00:00Z",
+	EndDate:   "2020-12-31T00:00:00Z",
+	Status:    models.TermStatusActive,
+}
+
+func Test_CheckCourseInTerm(t *testing.T) {
+	term := &models.TermModel{
+		ID:        1,
+		Name:      "Fall 2020",
+		StartDate: "2020-08-15T00:00:00Z",
+		EndDate:   "2020-12-31T00:00:00Z",
+		Status:    models.TermStatusActive,
+	}
+
+	testCases := []struct {
+		name           string
+		inputCourse    *models.CourseModel
+		expectedTerm   *models.TermModel
+		expectedError  error
+		mockRepoInput  string
+		mockRepoResult *repositories.TermEntity
+		mockRepoError  error
+	}{
+		{
+			name:           "term not found",
+			inputCourse:    &models.CourseModel{StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-03T00:00:00Z", Term: &models.TermModel{ID: 3}},
+			expectedError:  models.ErrTermNotFound,
+			mockRepoInput:  "3",
+			mockRepoResult: nil,
+			mockRepoError:  models.ErrTermNotFound,
+		},
+		{
+			name:           "course starts before the term",
+			inputCourse:    &models.CourseModel{StartTime: "2020-08-14T23:00:00Z", EndTime: "2020-11-03T00:00:00Z", Term: &models.TermModel{ID: 1}},
+			expectedError:  &models.CourseOutsideTermError{Term: term},
+			mockRepoInput:  "1",
+			mockRepoResult: fall2020,
+		},
+		{
+			name:           "course ends after the term",
+			inputCourse:    &models.CourseModel{StartTime: "2020-11-02T00:00:00Z", EndTime: "2021-01-01T00:00:01Z", Term: &models.TermModel{ID: 1}},
+			expectedError:  &models.CourseOutsideTermError{Term: term},
+			mockRepoInput:  "1",
+			mockRepoResult: fall2020,
+		},
+		{
+			name:           "course on the last day of the term",
+			inputCourse:    &models.CourseModel{StartTime: "2020-12-31T09:00:00Z", EndTime: "2020-12-31T11:00:00Z", Term: &models.TermModel{ID: 1}},
+			expectedTerm:   term,
+			expectedError:  nil,
+			mockRepoInput:  "1",
+			mockRepoResult: fall2020,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockTermRepository)
+			mockRepo.On("GetTermByID", testCase.mockRepoInput).Return(testCase.mockRepoResult, testCase.mockRepoError)
+
+			err := checkCourseInTerm(mockRepo, testCase.inputCourse)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedTerm, testCase.inputCourse.Term)
+			}
+		})
+	}
+}
+
+func Test_DeleteTerm(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+		mockRepoError error
+	}{
+		{
+			name:          "term still has courses",
+			input:         "1",
+			expectedError: models.ErrTermHasCourses,
+			mockRepoError: models.ErrTermHasCourses,
+		},
+		{
+			name:          "delete term successfully",
+			input:         "2",
+			expectedError: nil,
+			mockRepoError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MockTermRepository)
+			mockRepo.On("DeleteTerm", testCase.input).Return(testCase.mockRepoError)
+
+			termService := Term{
+				TermRepositories: mockRepo,
+			}
+
+			err := termService.DeleteTerm(testCase.input)
+
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
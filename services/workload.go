@@ -0,0 +1,164 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+)
+
+// teacherAssignmentRules holds the checks run before courses are assigned to a teacher
+type teacherAssignmentRules struct {
+	CourseRepositories  repositories.CourseRepositories
+	TeacherRepositories repositories.TeacherRepositories
+	TeacherWorkload     *models.TeacherWorkloadModel
+}
+
+// check rejects the course when it overlaps another course of its teacher or takes the teacher over the workload
+// of its term. sessions are the sessions the course will have, a course without sessions has no time to overlap yet.
+// weeklyMinutes is how long the course meets per week. id is empty for a new course.
+func (_self teacherAssignmentRules) check(id string, course *models.CourseModel, sessions []*repositories.SessionEntity, weeklyMinutes int) error {
+	if course.Teacher == nil || course.Teacher.ID == 0 {
+		return nil
+	}
+
+	teacherCourses, err := _self.teacherCourses(strconv.Itoa(course.Teacher.ID))
+	if err != nil {
+		return err
+	}
+
+	others := make([]*models.CourseModel, 0, len(teacherCourses))
+	for _, other := range teacherCourses {
+		if strconv.Itoa(other.ID) != id {
+			others = append(others, other)
+		}
+	}
+
+	// An archived teacher keeps the courses they have but takes no new one
+	if len(others) == len(teacherCourses) {
+		if err = _self.checkTeacherActive(course.Teacher.ID); err != nil {
+			return err
+		}
+	}
+
+	if err = _self.checkOverlap(course, sessions, others); err != nil {
+		return err
+	}
+	return _self.checkWorkload(course, weeklyMinutes, others)
+}
+
+func (_self teacherAssignmentRules) teacherCourses(teacherID string) ([]*models.CourseModel, error) {
+	return _self.CourseRepositories.GetCourses(&models.CourseFilterModel{TeacherID: teacherID})
+}
+
+func (_self teacherAssignmentRules) checkTeacherActive(teacherID int) error {
+	teacher, err := _self.TeacherRepositories.GetTeacherByID(strconv.Itoa(teacherID))
+	if err == sql.ErrNoRows {
+		return models.ErrTeacherNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if teacher.ArchivedAt != nil {
+		return models.ErrTeacherArchived
+	}
+	return nil
+}
+
+// checkOverlap compares the sessions of the courses whose time spans overlap. Only the courses with a meeting pattern
+// are compared, the teacher isn't busy for the whole time span of a course until it has sessions.
+func (_self teacherAssignmentRules) checkOverlap(course *models.CourseModel, sessions []*repositories.SessionEntity, others []*models.CourseModel) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+	candidates, err := findScheduleConflicts(course, others)
+	if err != nil || len(candidates) == 0 {
+		return err
+	}
+
+	spans, err := courseSpans(course, sessions)
+	if err != nil {
+		return err
+	}
+
+	conflicts := []*models.CourseModel{}
+	for _, candidate := range candidates {
+		candidateSessions, err := _self.CourseRepositories.GetCourseSessions(strconv.Itoa(candidate.ID))
+		if err != nil {
+			return err
+		}
+		if len(candidateSessions) == 0 {
+			continue
+		}
+		candidateSpans, err := courseSpans(candidate, candidateSessions)
+		if err != nil {
+			return err
+		}
+		if spansOverlap(spans, candidateSpans) {
+			conflicts = append(conflicts, candidate)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return &models.TeacherScheduleConflictError{Courses: conflicts}
+	}
+	return nil
+}
+
+// checkWorkload counts the courses of the teacher in the term of the course and their weekly meeting hours
+func (_self teacherAssignmentRules) checkWorkload(course *models.CourseModel, weeklyMinutes int, others []*models.CourseModel) error {
+	if _self.TeacherWorkload == nil {
+		return nil
+	}
+
+	sameTerm := []*models.CourseModel{}
+	for _, other := range others {
+		if other.Term != nil && other.Term.ID == course.Term.ID {
+			sameTerm = append(sameTerm, other)
+		}
+	}
+
+	if _self.TeacherWorkload.MaxCourses > 0 && len(sameTerm)+1 > _self.TeacherWorkload.MaxCourses {
+		return &models.TeacherWorkloadExceededError{
+			Code:    models.TeacherCourseLimit,
+			Term:    course.Term.Name,
+			Current: float64(len(sameTerm)),
+			Course:  1,
+			Limit:   float64(_self.TeacherWorkload.MaxCourses),
+		}
+	}
+
+	if _self.TeacherWorkload.MaxWeeklyHours == 0 || weeklyMinutes == 0 {
+		return nil
+	}
+	currentMinutes := 0
+	for _, other := range sameTerm {
+		pattern, err := _self.CourseRepositories.GetMeetingPattern(strconv.Itoa(other.ID))
+		if errors.Is(err, models.ErrMeetingPatternNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		currentMinutes += patternWeeklyMinutes(pattern)
+	}
+
+	if float64(currentMinutes+weeklyMinutes) > _self.TeacherWorkload.MaxWeeklyHours*60 {
+		return &models.TeacherWorkloadExceededError{
+			Code:    models.TeacherWeeklyHoursLimit,
+			Term:    course.Term.Name,
+			Current: float64(currentMinutes) / 60,
+			Course:  float64(weeklyMinutes) / 60,
+			Limit:   _self.TeacherWorkload.MaxWeeklyHours,
+		}
+	}
+	return nil
+}
+
+func patternWeeklyMinutes(pattern *repositories.MeetingPatternEntity) int {
+	if pattern == nil {
+		return 0
+	}
+	return len(pattern.DaysOfWeek) * pattern.DurationMinutes
+}
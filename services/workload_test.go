@@ -0,0 +1,187 @@
+package services
+
+import (
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"student_rest/models"
+	"student_rest/repositories"
+	"testing"
+)
+
+func Test_TeacherAssignmentRules(t *testing.T) {
+	fall := &models.TermModel{ID: 1, Name: "Fall 2020"}
+	spring := &models.TermModel{ID: 2, Name: "Spring 2021"}
+	teacher := &models.TeacherModel{ID: 1}
+	course := &models.CourseModel{Name: "Math", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-20T00:00:00Z", Term: fall, Teacher: teacher}
+	mondays := []*repositories.SessionEntity{
+		{Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+		{Date: "2020-11-09", StartTime: "2020-11-09T09:00:00Z", EndTime: "2020-11-09T10:30:00Z"},
+	}
+
+	physics := &models.CourseModel{ID: 2, Name: "Physics", StartTime: "2020-11-02T00:00:00Z", EndTime: "2020-11-20T00:00:00Z", Term: fall, Teacher: teacher}
+	chemistry := &models.CourseModel{ID: 3, Name: "Chemistry", StartTime: "2020-12-01T00:00:00Z", EndTime: "2020-12-02T00:00:00Z", Term: fall, Teacher: teacher}
+	biology := &models.CourseModel{ID: 4, Name: "Biology", StartTime: "2020-12-03T00:00:00Z", EndTime: "2020-12-04T00:00:00Z", Term: fall, Teacher: teacher}
+	history := &models.CourseModel{ID: 5, Name: "History", StartTime: "2021-02-01T00:00:00Z", EndTime: "2021-02-02T00:00:00Z", Term: spring, Teacher: teacher}
+
+	archivedAt := "2020-10-01T00:00:00Z"
+
+	testCases := []struct {
+		name          string
+		inputID       string
+		inputSessions []*repositories.SessionEntity
+		inputWeekly   int
+		expectedError error
+		mockTeacher   *repositories.TeacherEntity
+		mockCourses   []*models.CourseModel
+		mockSessions  map[string][]*repositories.SessionEntity
+		mockPatterns  map[string]*repositories.MeetingPatternEntity
+	}{
+		{
+			name:          "teacher has no other course",
+			inputID:       "",
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{},
+		},
+		{
+			name:          "course itself isn't a conflict",
+			inputID:       "2",
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{physics},
+		},
+		{
+			name:          "new course for an archived teacher",
+			inputID:       "",
+			expectedError: models.ErrTeacherArchived,
+			mockTeacher:   &repositories.TeacherEntity{ID: 1, ArchivedAt: &archivedAt},
+			mockCourses:   []*models.CourseModel{},
+		},
+		{
+			name:          "archived teacher keeps their course",
+			inputID:       "2",
+			expectedError: nil,
+			mockTeacher:   &repositories.TeacherEntity{ID: 1, ArchivedAt: &archivedAt},
+			mockCourses:   []*models.CourseModel{physics},
+		},
+		{
+			name:          "course without sessions doesn't overlap",
+			inputID:       "",
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{physics},
+			mockSessions: map[string][]*repositories.SessionEntity{"2": {
+				{Date: "2020-11-02", StartTime: "2020-11-02T09:00:00Z", EndTime: "2020-11-02T10:30:00Z"},
+			}},
+		},
+		{
+			name:          "other course without sessions doesn't overlap",
+			inputID:       "",
+			inputSessions: mondays,
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{physics},
+			mockSessions:  map[string][]*repositories.SessionEntity{"2": {}},
+		},
+		{
+			name:          "sessions on other days",
+			inputID:       "",
+			inputSessions: mondays,
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{physics},
+			mockSessions: map[string][]*repositories.SessionEntity{"2": {
+				{Date: "2020-11-03", StartTime: "2020-11-03T09:00:00Z", EndTime: "2020-11-03T10:30:00Z"},
+			}},
+		},
+		{
+			name:          "sessions overlap",
+			inputID:       "",
+			inputSessions: mondays,
+			expectedError: &models.TeacherScheduleConflictError{Courses: []*models.CourseModel{physics}},
+			mockCourses:   []*models.CourseModel{physics},
+			mockSessions: map[string][]*repositories.SessionEntity{"2": {
+				{Date: "2020-11-09", StartTime: "2020-11-09T10:00:00Z", EndTime: "2020-11-09T11:00:00Z"},
+			}},
+		},
+		{
+			name:    "course limit of the term reached",
+			inputID: "",
+			expectedError: &models.TeacherWorkloadExceededError{
+				Code:    models.TeacherCourseLimit,
+				Term:    "Fall 2020",
+				Current: 2,
+				Course:  1,
+				Limit:   2,
+			},
+			mockCourses: []*models.CourseModel{chemistry, biology},
+		},
+		{
+			name:          "courses of other terms don't count",
+			inputID:       "",
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{chemistry, history},
+		},
+		{
+			name:        "weekly hours limit reached",
+			inputID:     "",
+			inputWeekly: 90,
+			expectedError: &models.TeacherWorkloadExceededError{
+				Code:    models.TeacherWeeklyHoursLimit,
+				Term:    "Fall 2020",
+				Current: 3,
+				Course:  1.5,
+				Limit:   4,
+			},
+			mockCourses: []*models.CourseModel{chemistry},
+			mockPatterns: map[string]*repositories.MeetingPatternEntity{
+				"3": {CourseID: 3, DaysOfWeek: []string{"tuesday", "thursday"}, DurationMinutes: 90},
+			},
+		},
+		{
+			name:          "weekly hours within the limit",
+			inputID:       "",
+			inputWeekly:   60,
+			expectedError: nil,
+			mockCourses:   []*models.CourseModel{chemistry},
+			mockPatterns: map[string]*repositories.MeetingPatternEntity{
+				"3": {CourseID: 3, DaysOfWeek: []string{"tuesday", "thursday"}, DurationMinutes: 90},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(MocCourseRepository)
+			mockRepo.On("GetCourses", &models.CourseFilterModel{TeacherID: "1"}).Return(testCase.mockCourses, nil)
+			for id, sessions := range testCase.mockSessions {
+				mockRepo.On("GetCourseSessions", id).Return(sessions, nil)
+			}
+			for _, other := range testCase.mockCourses {
+				id := strconv.Itoa(other.ID)
+				if pattern, ok := testCase.mockPatterns[id]; ok {
+					mockRepo.On("GetMeetingPattern", id).Return(pattern, nil)
+					continue
+				}
+				var noPattern *repositories.MeetingPatternEntity
+				mockRepo.On("GetMeetingPattern", id).Return(noPattern, models.ErrMeetingPatternNotFound)
+			}
+
+			mockTeacher := testCase.mockTeacher
+			if mockTeacher == nil {
+				mockTeacher = &repositories.TeacherEntity{ID: 1}
+			}
+			mockTeacherRepo := new(MockTeacherRepository)
+			mockTeacherRepo.On("GetTeacherByID", "1").Return(mockTeacher, nil)
+
+			rules := teacherAssignmentRules{
+				CourseRepositories:  mockRepo,
+				TeacherRepositories: mockTeacherRepo,
+				TeacherWorkload:     &models.TeacherWorkloadModel{MaxWeeklyHours: 4, MaxCourses: 2},
+			}
+
+			err := rules.check(testCase.inputID, course, testCase.inputSessions, testCase.inputWeekly)
+
+			if testCase.expectedError != nil {
+				require.Equal(t, testCase.expectedError, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}